@@ -9,7 +9,6 @@ variable "service_labels" {
     "account",
     "acm",
     "acmpca",
-    "alexaforbusiness",
     "amp",
     "amplify",
     "apigateway",
@@ -104,7 +103,6 @@ variable "service_labels" {
     "greengrass",
     "groundstation",
     "guardduty",
-    "honeycode",
     "iam",
     "identitystore",
     "imagebuilder",
@@ -131,7 +129,6 @@ variable "service_labels" {
     "lightsail",
     "location",
     "machinelearning",
-    "macie",
     "macie2",
     "managedblockchain",
     "marketplacecatalog",
@@ -144,7 +141,6 @@ variable "service_labels" {
     "mediatailor",
     "memorydb",
     "meteringmarketplace",
-    "mobile",
     "mq",
     "mwaa",
     "neptune",
@@ -169,6 +165,7 @@ variable "service_labels" {
     "resourcegroups",
     "resourcegroupstaggingapi",
     "robomaker",
+    "rolesanywhere",
     "route53",
     "route53domains",
     "route53recoverycontrolconfig",
@@ -209,6 +206,7 @@ variable "service_labels" {
     "timestreamwrite",
     "transfer",
     "translate",
+    "vpclattice",
     "waf",
     "wafv2",
     "workdocs",
@@ -1,11 +1,18 @@
 package conns
 
 import (
+	"crypto/tls"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -13,7 +20,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -123,7 +129,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/aws/aws-sdk-go/service/healthlake"
-	"github.com/aws/aws-sdk-go/service/honeycode"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
@@ -166,7 +171,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
@@ -185,7 +189,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/mgn"
 	"github.com/aws/aws-sdk-go/service/migrationhub"
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
-	"github.com/aws/aws-sdk-go/service/mobile"
 	"github.com/aws/aws-sdk-go/service/mobileanalytics"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mturk"
@@ -217,10 +220,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/rdsdataservice"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
 	"github.com/aws/aws-sdk-go/service/rekognition"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/robomaker"
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
@@ -269,6 +274,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/aws/aws-sdk-go/service/wafv2"
@@ -281,7 +287,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -291,7 +299,6 @@ const (
 	Account                       = "account"
 	ACM                           = "acm"
 	ACMPCA                        = "acmpca"
-	AlexaForBusiness              = "alexaforbusiness"
 	AMP                           = "amp"
 	Amplify                       = "amplify"
 	AmplifyBackend                = "amplifybackend"
@@ -404,7 +411,6 @@ const (
 	GuardDuty                     = "guardduty"
 	Health                        = "health"
 	HealthLake                    = "healthlake"
-	Honeycode                     = "honeycode"
 	IAM                           = "iam"
 	IdentityStore                 = "identitystore"
 	ImageBuilder                  = "imagebuilder"
@@ -448,7 +454,6 @@ const (
 	LookoutForVision              = "lookoutforvision"
 	LookoutMetrics                = "lookoutmetrics"
 	MachineLearning               = "machinelearning"
-	Macie                         = "macie"
 	Macie2                        = "macie2"
 	ManagedBlockchain             = "managedblockchain"
 	MarketplaceCatalog            = "marketplacecatalog"
@@ -467,7 +472,6 @@ const (
 	Mgn                           = "mgn"
 	MigrationHub                  = "migrationhub"
 	MigrationHubConfig            = "migrationhubconfig"
-	Mobile                        = "mobile"
 	MobileAnalytics               = "mobileanalytics"
 	MQ                            = "mq"
 	MTurk                         = "mturk"
@@ -498,10 +502,12 @@ const (
 	RDSData                       = "rdsdata"
 	Redshift                      = "redshift"
 	RedshiftData                  = "redshiftdata"
+	RedshiftServerless            = "redshiftserverless"
 	Rekognition                   = "rekognition"
 	ResourceGroups                = "resourcegroups"
 	ResourceGroupsTaggingAPI      = "resourcegroupstaggingapi"
 	RoboMaker                     = "robomaker"
+	RolesAnywhere                 = "rolesanywhere"
 	Route53                       = "route53"
 	Route53Domains                = "route53domains"
 	Route53RecoveryControlConfig  = "route53recoverycontrolconfig"
@@ -550,6 +556,7 @@ const (
 	TranscribeStreaming           = "transcribestreaming"
 	Transfer                      = "transfer"
 	Translate                     = "translate"
+	VPCLattice                    = "vpclattice"
 	WAF                           = "waf"
 	WAFRegional                   = "wafregional"
 	WAFV2                         = "wafv2"
@@ -580,7 +587,6 @@ func init() {
 	serviceData[Account] = &ServiceDatum{AWSClientName: "Account", AWSServiceName: account.ServiceName, AWSEndpointsID: account.EndpointsID, AWSServiceID: account.ServiceID, ProviderNameUpper: "Account", HCLKeys: []string{"account"}}
 	serviceData[ACM] = &ServiceDatum{AWSClientName: "ACM", AWSServiceName: acm.ServiceName, AWSEndpointsID: acm.EndpointsID, AWSServiceID: acm.ServiceID, ProviderNameUpper: "ACM", HCLKeys: []string{"acm"}}
 	serviceData[ACMPCA] = &ServiceDatum{AWSClientName: "ACMPCA", AWSServiceName: acmpca.ServiceName, AWSEndpointsID: acmpca.EndpointsID, AWSServiceID: acmpca.ServiceID, ProviderNameUpper: "ACMPCA", HCLKeys: []string{"acmpca"}}
-	serviceData[AlexaForBusiness] = &ServiceDatum{AWSClientName: "AlexaForBusiness", AWSServiceName: alexaforbusiness.ServiceName, AWSEndpointsID: alexaforbusiness.EndpointsID, AWSServiceID: alexaforbusiness.ServiceID, ProviderNameUpper: "AlexaForBusiness", HCLKeys: []string{"alexaforbusiness"}}
 	serviceData[AMP] = &ServiceDatum{AWSClientName: "PrometheusService", AWSServiceName: prometheusservice.ServiceName, AWSEndpointsID: prometheusservice.EndpointsID, AWSServiceID: prometheusservice.ServiceID, ProviderNameUpper: "AMP", HCLKeys: []string{"amp", "prometheus", "prometheusservice"}}
 	serviceData[Amplify] = &ServiceDatum{AWSClientName: "Amplify", AWSServiceName: amplify.ServiceName, AWSEndpointsID: amplify.EndpointsID, AWSServiceID: amplify.ServiceID, ProviderNameUpper: "Amplify", HCLKeys: []string{"amplify"}}
 	serviceData[AmplifyBackend] = &ServiceDatum{AWSClientName: "AmplifyBackend", AWSServiceName: amplifybackend.ServiceName, AWSEndpointsID: amplifybackend.EndpointsID, AWSServiceID: amplifybackend.ServiceID, ProviderNameUpper: "AmplifyBackend", HCLKeys: []string{"amplifybackend"}}
@@ -691,7 +697,6 @@ func init() {
 	serviceData[GuardDuty] = &ServiceDatum{AWSClientName: "GuardDuty", AWSServiceName: guardduty.ServiceName, AWSEndpointsID: guardduty.EndpointsID, AWSServiceID: guardduty.ServiceID, ProviderNameUpper: "GuardDuty", HCLKeys: []string{"guardduty"}}
 	serviceData[Health] = &ServiceDatum{AWSClientName: "Health", AWSServiceName: health.ServiceName, AWSEndpointsID: health.EndpointsID, AWSServiceID: health.ServiceID, ProviderNameUpper: "Health", HCLKeys: []string{"health"}}
 	serviceData[HealthLake] = &ServiceDatum{AWSClientName: "HealthLake", AWSServiceName: healthlake.ServiceName, AWSEndpointsID: healthlake.EndpointsID, AWSServiceID: healthlake.ServiceID, ProviderNameUpper: "HealthLake", HCLKeys: []string{"healthlake"}}
-	serviceData[Honeycode] = &ServiceDatum{AWSClientName: "Honeycode", AWSServiceName: honeycode.ServiceName, AWSEndpointsID: honeycode.EndpointsID, AWSServiceID: honeycode.ServiceID, ProviderNameUpper: "Honeycode", HCLKeys: []string{"honeycode"}}
 	serviceData[IAM] = &ServiceDatum{AWSClientName: "IAM", AWSServiceName: iam.ServiceName, AWSEndpointsID: iam.EndpointsID, AWSServiceID: iam.ServiceID, ProviderNameUpper: "IAM", HCLKeys: []string{"iam"}}
 	serviceData[IdentityStore] = &ServiceDatum{AWSClientName: "IdentityStore", AWSServiceName: identitystore.ServiceName, AWSEndpointsID: identitystore.EndpointsID, AWSServiceID: identitystore.ServiceID, ProviderNameUpper: "IdentityStore", HCLKeys: []string{"identitystore"}}
 	serviceData[ImageBuilder] = &ServiceDatum{AWSClientName: "ImageBuilder", AWSServiceName: imagebuilder.ServiceName, AWSEndpointsID: imagebuilder.EndpointsID, AWSServiceID: imagebuilder.ServiceID, ProviderNameUpper: "ImageBuilder", HCLKeys: []string{"imagebuilder"}}
@@ -734,7 +739,6 @@ func init() {
 	serviceData[LookoutForVision] = &ServiceDatum{AWSClientName: "LookoutForVision", AWSServiceName: lookoutforvision.ServiceName, AWSEndpointsID: lookoutforvision.EndpointsID, AWSServiceID: lookoutforvision.ServiceID, ProviderNameUpper: "LookoutForVision", HCLKeys: []string{"lookoutforvision"}}
 	serviceData[LookoutMetrics] = &ServiceDatum{AWSClientName: "LookoutMetrics", AWSServiceName: lookoutmetrics.ServiceName, AWSEndpointsID: lookoutmetrics.EndpointsID, AWSServiceID: lookoutmetrics.ServiceID, ProviderNameUpper: "LookoutMetrics", HCLKeys: []string{"lookoutmetrics"}}
 	serviceData[MachineLearning] = &ServiceDatum{AWSClientName: "MachineLearning", AWSServiceName: machinelearning.ServiceName, AWSEndpointsID: machinelearning.EndpointsID, AWSServiceID: machinelearning.ServiceID, ProviderNameUpper: "MachineLearning", HCLKeys: []string{"machinelearning"}}
-	serviceData[Macie] = &ServiceDatum{AWSClientName: "Macie", AWSServiceName: macie.ServiceName, AWSEndpointsID: macie.EndpointsID, AWSServiceID: macie.ServiceID, ProviderNameUpper: "Macie", HCLKeys: []string{"macie"}}
 	serviceData[Macie2] = &ServiceDatum{AWSClientName: "Macie2", AWSServiceName: macie2.ServiceName, AWSEndpointsID: macie2.EndpointsID, AWSServiceID: macie2.ServiceID, ProviderNameUpper: "Macie2", HCLKeys: []string{"macie2"}}
 	serviceData[ManagedBlockchain] = &ServiceDatum{AWSClientName: "ManagedBlockchain", AWSServiceName: managedblockchain.ServiceName, AWSEndpointsID: managedblockchain.EndpointsID, AWSServiceID: managedblockchain.ServiceID, ProviderNameUpper: "ManagedBlockchain", HCLKeys: []string{"managedblockchain"}}
 	serviceData[MarketplaceCatalog] = &ServiceDatum{AWSClientName: "MarketplaceCatalog", AWSServiceName: marketplacecatalog.ServiceName, AWSEndpointsID: marketplacecatalog.EndpointsID, AWSServiceID: marketplacecatalog.ServiceID, ProviderNameUpper: "MarketplaceCatalog", HCLKeys: []string{"marketplacecatalog"}}
@@ -753,7 +757,6 @@ func init() {
 	serviceData[Mgn] = &ServiceDatum{AWSClientName: "Mgn", AWSServiceName: mgn.ServiceName, AWSEndpointsID: mgn.EndpointsID, AWSServiceID: mgn.ServiceID, ProviderNameUpper: "Mgn", HCLKeys: []string{"mgn"}}
 	serviceData[MigrationHub] = &ServiceDatum{AWSClientName: "MigrationHub", AWSServiceName: migrationhub.ServiceName, AWSEndpointsID: migrationhub.EndpointsID, AWSServiceID: migrationhub.ServiceID, ProviderNameUpper: "MigrationHub", HCLKeys: []string{"migrationhub"}}
 	serviceData[MigrationHubConfig] = &ServiceDatum{AWSClientName: "MigrationHubConfig", AWSServiceName: migrationhubconfig.ServiceName, AWSEndpointsID: migrationhubconfig.EndpointsID, AWSServiceID: migrationhubconfig.ServiceID, ProviderNameUpper: "MigrationHubConfig", HCLKeys: []string{"migrationhubconfig"}}
-	serviceData[Mobile] = &ServiceDatum{AWSClientName: "Mobile", AWSServiceName: mobile.ServiceName, AWSEndpointsID: mobile.EndpointsID, AWSServiceID: mobile.ServiceID, ProviderNameUpper: "Mobile", HCLKeys: []string{"mobile"}}
 	serviceData[MobileAnalytics] = &ServiceDatum{AWSClientName: "MobileAnalytics", AWSServiceName: mobileanalytics.ServiceName, AWSEndpointsID: mobileanalytics.EndpointsID, AWSServiceID: mobileanalytics.ServiceID, ProviderNameUpper: "MobileAnalytics", HCLKeys: []string{"mobileanalytics"}}
 	serviceData[MQ] = &ServiceDatum{AWSClientName: "MQ", AWSServiceName: mq.ServiceName, AWSEndpointsID: mq.EndpointsID, AWSServiceID: mq.ServiceID, ProviderNameUpper: "MQ", HCLKeys: []string{"mq"}}
 	serviceData[MTurk] = &ServiceDatum{AWSClientName: "MTurk", AWSServiceName: mturk.ServiceName, AWSEndpointsID: mturk.EndpointsID, AWSServiceID: mturk.ServiceID, ProviderNameUpper: "MTurk", HCLKeys: []string{"mturk"}}
@@ -784,10 +787,12 @@ func init() {
 	serviceData[RDSData] = &ServiceDatum{AWSClientName: "RDSDataService", AWSServiceName: rdsdataservice.ServiceName, AWSEndpointsID: rdsdataservice.EndpointsID, AWSServiceID: rdsdataservice.ServiceID, ProviderNameUpper: "RDSData", HCLKeys: []string{"rdsdata", "rdsdataservice"}}
 	serviceData[Redshift] = &ServiceDatum{AWSClientName: "Redshift", AWSServiceName: redshift.ServiceName, AWSEndpointsID: redshift.EndpointsID, AWSServiceID: redshift.ServiceID, ProviderNameUpper: "Redshift", HCLKeys: []string{"redshift"}}
 	serviceData[RedshiftData] = &ServiceDatum{AWSClientName: "RedshiftData", AWSServiceName: redshiftdataapiservice.ServiceName, AWSEndpointsID: redshiftdataapiservice.EndpointsID, AWSServiceID: redshiftdataapiservice.ServiceID, ProviderNameUpper: "RedshiftData", HCLKeys: []string{"redshiftdata"}}
+	serviceData[RedshiftServerless] = &ServiceDatum{AWSClientName: "RedshiftServerless", AWSServiceName: redshiftserverless.ServiceName, AWSEndpointsID: redshiftserverless.EndpointsID, AWSServiceID: redshiftserverless.ServiceID, ProviderNameUpper: "RedshiftServerless", HCLKeys: []string{"redshiftserverless"}}
 	serviceData[Rekognition] = &ServiceDatum{AWSClientName: "Rekognition", AWSServiceName: rekognition.ServiceName, AWSEndpointsID: rekognition.EndpointsID, AWSServiceID: rekognition.ServiceID, ProviderNameUpper: "Rekognition", HCLKeys: []string{"rekognition"}}
 	serviceData[ResourceGroups] = &ServiceDatum{AWSClientName: "ResourceGroups", AWSServiceName: resourcegroups.ServiceName, AWSEndpointsID: resourcegroups.EndpointsID, AWSServiceID: resourcegroups.ServiceID, ProviderNameUpper: "ResourceGroups", HCLKeys: []string{"resourcegroups"}}
 	serviceData[ResourceGroupsTaggingAPI] = &ServiceDatum{AWSClientName: "ResourceGroupsTaggingAPI", AWSServiceName: resourcegroupstaggingapi.ServiceName, AWSEndpointsID: resourcegroupstaggingapi.EndpointsID, AWSServiceID: resourcegroupstaggingapi.ServiceID, ProviderNameUpper: "ResourceGroupsTaggingAPI", HCLKeys: []string{"resourcegroupstaggingapi", "resourcegroupstagging"}}
 	serviceData[RoboMaker] = &ServiceDatum{AWSClientName: "RoboMaker", AWSServiceName: robomaker.ServiceName, AWSEndpointsID: robomaker.EndpointsID, AWSServiceID: robomaker.ServiceID, ProviderNameUpper: "RoboMaker", HCLKeys: []string{"robomaker"}}
+	serviceData[RolesAnywhere] = &ServiceDatum{AWSClientName: "RolesAnywhere", AWSServiceName: rolesanywhere.ServiceName, AWSEndpointsID: rolesanywhere.EndpointsID, AWSServiceID: rolesanywhere.ServiceID, ProviderNameUpper: "RolesAnywhere", HCLKeys: []string{"rolesanywhere"}}
 	serviceData[Route53] = &ServiceDatum{AWSClientName: "Route53", AWSServiceName: route53.ServiceName, AWSEndpointsID: route53.EndpointsID, AWSServiceID: route53.ServiceID, ProviderNameUpper: "Route53", HCLKeys: []string{"route53"}}
 	serviceData[Route53Domains] = &ServiceDatum{AWSClientName: "Route53Domains", AWSServiceName: route53domains.ServiceName, AWSEndpointsID: route53domains.EndpointsID, AWSServiceID: route53domains.ServiceID, ProviderNameUpper: "Route53Domains", HCLKeys: []string{"route53domains"}}
 	serviceData[Route53RecoveryControlConfig] = &ServiceDatum{AWSClientName: "Route53RecoveryControlConfig", AWSServiceName: route53recoverycontrolconfig.ServiceName, AWSEndpointsID: route53recoverycontrolconfig.EndpointsID, AWSServiceID: route53recoverycontrolconfig.ServiceID, ProviderNameUpper: "Route53RecoveryControlConfig", HCLKeys: []string{"route53recoverycontrolconfig"}}
@@ -836,6 +841,7 @@ func init() {
 	serviceData[TranscribeStreaming] = &ServiceDatum{AWSClientName: "TranscribeStreamingService", AWSServiceName: transcribestreamingservice.ServiceName, AWSEndpointsID: transcribestreamingservice.EndpointsID, AWSServiceID: transcribestreamingservice.ServiceID, ProviderNameUpper: "TranscribeStreaming", HCLKeys: []string{"transcribestreaming", "transcribestreamingservice"}}
 	serviceData[Transfer] = &ServiceDatum{AWSClientName: "Transfer", AWSServiceName: transfer.ServiceName, AWSEndpointsID: transfer.EndpointsID, AWSServiceID: transfer.ServiceID, ProviderNameUpper: "Transfer", HCLKeys: []string{"transfer"}}
 	serviceData[Translate] = &ServiceDatum{AWSClientName: "Translate", AWSServiceName: translate.ServiceName, AWSEndpointsID: translate.EndpointsID, AWSServiceID: translate.ServiceID, ProviderNameUpper: "Translate", HCLKeys: []string{"translate"}}
+	serviceData[VPCLattice] = &ServiceDatum{AWSClientName: "VPCLattice", AWSServiceName: vpclattice.ServiceName, AWSEndpointsID: vpclattice.EndpointsID, AWSServiceID: vpclattice.ServiceID, ProviderNameUpper: "VPCLattice", HCLKeys: []string{"vpclattice"}}
 	serviceData[WAF] = &ServiceDatum{AWSClientName: "WAF", AWSServiceName: waf.ServiceName, AWSEndpointsID: waf.EndpointsID, AWSServiceID: waf.ServiceID, ProviderNameUpper: "WAF", HCLKeys: []string{"waf"}}
 	serviceData[WAFRegional] = &ServiceDatum{AWSClientName: "WAFRegional", AWSServiceName: wafregional.ServiceName, AWSEndpointsID: wafregional.EndpointsID, AWSServiceID: wafregional.ServiceID, ProviderNameUpper: "WAFRegional", HCLKeys: []string{"wafregional"}}
 	serviceData[WAFV2] = &ServiceDatum{AWSClientName: "WAFV2", AWSServiceName: wafv2.ServiceName, AWSEndpointsID: wafv2.EndpointsID, AWSServiceID: wafv2.ServiceID, ProviderNameUpper: "WAFV2", HCLKeys: []string{"wafv2"}}
@@ -856,6 +862,10 @@ type Config struct {
 	Token         string
 	Region        string
 	MaxRetries    int
+	RetryMode     string
+
+	// ServiceMaxRetries overrides MaxRetries for individual services, keyed by service.
+	ServiceMaxRetries map[string]int
 
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
@@ -866,11 +876,22 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	// AdditionalAssumeRoles are assumed in order after the role above,
+	// each with the credentials of the previously assumed role.
+	AdditionalAssumeRoles []AssumeRole
+
+	AssumeRoleWithWebIdentityARN             string
+	AssumeRoleWithWebIdentityDurationSeconds int
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityToken           string
+	AssumeRoleWithWebIdentityTokenFile       string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
 	DefaultTagsConfig *tftags.DefaultConfig
 	Endpoints         map[string]string
+	EndpointURL       string
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Insecure          bool
 	HTTPProxy         string
@@ -882,16 +903,30 @@ type Config struct {
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 
+	ElastiCacheDefaultDescription string
+	ElastiCacheValidateParameters bool
+
 	TerraformVersion string
 }
 
+// AssumeRole is the configuration of a role assumed by the provider.
+type AssumeRole struct {
+	RoleARN           string
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
 type AWSClient struct {
 	AccessAnalyzerConn                *accessanalyzer.AccessAnalyzer
 	AccountConn                       *account.Account
 	AccountID                         string
 	ACMConn                           *acm.ACM
 	ACMPCAConn                        *acmpca.ACMPCA
-	AlexaForBusinessConn              *alexaforbusiness.AlexaForBusiness
 	AMPConn                           *prometheusservice.PrometheusService
 	AmplifyBackendConn                *amplifybackend.AmplifyBackend
 	AmplifyConn                       *amplify.Amplify
@@ -975,6 +1010,8 @@ type AWSClient struct {
 	EFSConn                           *efs.EFS
 	EKSConn                           *eks.EKS
 	ElastiCacheConn                   *elasticache.ElastiCache
+	ElastiCacheDefaultDescription     string
+	ElastiCacheValidateParameters     bool
 	ElasticBeanstalkConn              *elasticbeanstalk.ElasticBeanstalk
 	ElasticInferenceConn              *elasticinference.ElasticInference
 	ElasticsearchConn                 *elasticsearch.ElasticsearchService
@@ -1004,7 +1041,6 @@ type AWSClient struct {
 	GuardDutyConn                     *guardduty.GuardDuty
 	HealthConn                        *health.Health
 	HealthLakeConn                    *healthlake.HealthLake
-	HoneycodeConn                     *honeycode.Honeycode
 	IAMConn                           *iam.IAM
 	IdentityStoreConn                 *identitystore.IdentityStore
 	IgnoreTagsConfig                  *tftags.IgnoreConfig
@@ -1049,7 +1085,6 @@ type AWSClient struct {
 	LookoutMetricsConn                *lookoutmetrics.LookoutMetrics
 	MachineLearningConn               *machinelearning.MachineLearning
 	Macie2Conn                        *macie2.Macie2
-	MacieConn                         *macie.Macie
 	ManagedBlockchainConn             *managedblockchain.ManagedBlockchain
 	MarketplaceCatalogConn            *marketplacecatalog.MarketplaceCatalog
 	MarketplaceCommerceAnalyticsConn  *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
@@ -1069,7 +1104,6 @@ type AWSClient struct {
 	MigrationHubConfigConn            *migrationhubconfig.MigrationHubConfig
 	MigrationHubConn                  *migrationhub.MigrationHub
 	MobileAnalyticsConn               *mobileanalytics.MobileAnalytics
-	MobileConn                        *mobile.Mobile
 	MQConn                            *mq.MQ
 	MTurkConn                         *mturk.MTurk
 	MWAAConn                          *mwaa.MWAA
@@ -1100,12 +1134,14 @@ type AWSClient struct {
 	RDSDataConn                       *rdsdataservice.RDSDataService
 	RedshiftConn                      *redshift.Redshift
 	RedshiftDataConn                  *redshiftdataapiservice.RedshiftDataAPIService
+	RedshiftServerlessConn            *redshiftserverless.RedshiftServerless
 	Region                            string
 	RekognitionConn                   *rekognition.Rekognition
 	ResourceGroupsConn                *resourcegroups.ResourceGroups
 	ResourceGroupsTaggingAPIConn      *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	ReverseDNSPrefix                  string
 	RoboMakerConn                     *robomaker.RoboMaker
+	RolesAnywhereConn                 *rolesanywhere.RolesAnywhere
 	Route53Conn                       *route53.Route53
 	Route53DomainsConn                *route53domains.Route53Domains
 	Route53RecoveryControlConfigConn  *route53recoverycontrolconfig.Route53RecoveryControlConfig
@@ -1157,6 +1193,7 @@ type AWSClient struct {
 	TranscribeStreamingConn           *transcribestreamingservice.TranscribeStreamingService
 	TransferConn                      *transfer.Transfer
 	TranslateConn                     *translate.Translate
+	VPCLatticeConn                    *vpclattice.VPCLattice
 	WAFConn                           *waf.WAF
 	WAFRegionalConn                   *wafregional.WAFRegional
 	WAFV2Conn                         *wafv2.WAFV2
@@ -1207,7 +1244,7 @@ func (c *Config) Client() (interface{}, error) {
 		CallerName:                  "Terraform AWS Provider",
 		CredsFilename:               c.CredsFilename,
 		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints[IAM],
+		IamEndpoint:                 c.endpoint(IAM),
 		Insecure:                    c.Insecure,
 		HTTPProxy:                   c.HTTPProxy,
 		MaxRetries:                  c.MaxRetries,
@@ -1217,16 +1254,78 @@ func (c *Config) Client() (interface{}, error) {
 		SkipCredsValidation:         c.SkipCredsValidation,
 		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
 		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints[STS],
+		StsEndpoint:                 c.endpoint(STS),
 		Token:                       c.Token,
 		UserAgentProducts:           StdUserAgentProducts(c.TerraformVersion),
 	}
 
+	var webIdentityCreds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		creds, err := c.webIdentityCredentials()
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		value, err := creds.Get()
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: error assuming role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityARN, err)
+		}
+
+		// The base session is validated with the current web identity credentials.
+		// Any assume_role configuration is applied below so that the role is
+		// assumed with the refreshing web identity credentials.
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.Token = value.SessionToken
+		awsbaseConfig.AssumeRoleARN = ""
+		webIdentityCreds = creds
+	}
+
 	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	// Replace the static web identity credentials with the refreshing provider
+	// so that long-running operations outlive the initial role session.
+	if webIdentityCreds != nil {
+		sess.Config.Credentials = webIdentityCreds
+
+		if c.AssumeRoleARN != "" {
+			creds, err := c.assumeRoleCredentials(sess, c.assumeRole())
+			if err != nil {
+				return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+			}
+
+			sess.Config.Credentials = creds
+
+			if v, err := arn.Parse(c.AssumeRoleARN); err == nil {
+				accountID, Partition = v.AccountID, v.Partition
+			}
+		}
+	}
+
+	// Chained roles are assumed one hop at a time with the credentials of the previous hop.
+	for i := range c.AdditionalAssumeRoles {
+		role := &c.AdditionalAssumeRoles[i]
+
+		creds, err := c.assumeRoleCredentials(sess, role)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		sess.Config.Credentials = creds
+
+		if v, err := arn.Parse(role.RoleARN); err == nil {
+			accountID, Partition = v.AccountID, v.Partition
+		}
+	}
+
+	if c.RetryMode == RetryModeAdaptive {
+		NewAdaptiveRateLimiter().Attach(&sess.Handlers)
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -1241,304 +1340,293 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	client := &AWSClient{
-		AccessAnalyzerConn:                accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AccessAnalyzer])})),
-		AccountConn:                       account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Account])})),
+		AccessAnalyzerConn:                accessanalyzer.New(sess.Copy(c.serviceConfig(AccessAnalyzer))),
+		AccountConn:                       account.New(sess.Copy(c.serviceConfig(Account))),
 		AccountID:                         accountID,
-		ACMConn:                           acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACM])})),
-		ACMPCAConn:                        acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACMPCA])})),
-		AlexaForBusinessConn:              alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AlexaForBusiness])})),
-		AMPConn:                           prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AMP])})),
-		AmplifyBackendConn:                amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AmplifyBackend])})),
-		AmplifyConn:                       amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Amplify])})),
-		APIGatewayConn:                    apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGateway])})),
-		APIGatewayV2Conn:                  apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGatewayV2])})),
-		AppAutoScalingConn:                applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppAutoScaling])})),
-		AppConfigConn:                     appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppConfig])})),
-		AppFlowConn:                       appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppFlow])})),
-		AppIntegrationsConn:               appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppIntegrations])})),
-		ApplicationCostProfilerConn:       applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationCostProfiler])})),
-		ApplicationDiscoveryConn:          applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationDiscovery])})),
-		ApplicationInsightsConn:           applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationInsights])})),
-		AppMeshConn:                       appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppMesh])})),
-		AppRegistryConn:                   appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRegistry])})),
-		AppRunnerConn:                     apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRunner])})),
-		AppStreamConn:                     appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppStream])})),
-		AppSyncConn:                       appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppSync])})),
-		AthenaConn:                        athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Athena])})),
-		AuditManagerConn:                  auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AuditManager])})),
-		AugmentedAIRuntimeConn:            augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AugmentedAIRuntime])})),
-		AutoScalingConn:                   autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScaling])})),
-		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScalingPlans])})),
-		BackupConn:                        backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Backup])})),
-		BatchConn:                         batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Batch])})),
-		BraketConn:                        braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Braket])})),
-		BudgetsConn:                       budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Budgets])})),
-		ChimeConn:                         chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Chime])})),
-		Cloud9Conn:                        cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Cloud9])})),
-		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudControl])})),
-		CloudDirectoryConn:                clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudDirectory])})),
-		CloudFormationConn:                cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFormation])})),
-		CloudFrontConn:                    cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFront])})),
-		CloudHSMV2Conn:                    cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudHSMV2])})),
-		CloudSearchConn:                   cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearch])})),
-		CloudSearchDomainConn:             cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearchDomain])})),
-		CloudTrailConn:                    cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudTrail])})),
-		CloudWatchConn:                    cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatch])})),
-		CloudWatchLogsConn:                cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatchLogs])})),
-		CodeArtifactConn:                  codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeArtifact])})),
-		CodeBuildConn:                     codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeBuild])})),
-		CodeCommitConn:                    codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeCommit])})),
-		CodeDeployConn:                    codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeDeploy])})),
-		CodeGuruProfilerConn:              codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruProfiler])})),
-		CodeGuruReviewerConn:              codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruReviewer])})),
-		CodePipelineConn:                  codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodePipeline])})),
-		CodeStarConn:                      codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStar])})),
-		CodeStarConnectionsConn:           codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarConnections])})),
-		CodeStarNotificationsConn:         codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarNotifications])})),
-		CognitoIdentityConn:               cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIdentity])})),
-		CognitoIDPConn:                    cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIDP])})),
-		CognitoSyncConn:                   cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoSync])})),
-		ComprehendConn:                    comprehend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Comprehend])})),
-		ComprehendMedicalConn:             comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ComprehendMedical])})),
-		ConfigServiceConn:                 configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConfigService])})),
-		ConnectConn:                       connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Connect])})),
-		ConnectContactLensConn:            connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectContactLens])})),
-		ConnectParticipantConn:            connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectParticipant])})),
-		CostExplorerConn:                  costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CostExplorer])})),
-		CURConn:                           costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CUR])})),
-		DataExchangeConn:                  dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataExchange])})),
-		DataPipelineConn:                  datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataPipeline])})),
-		DataSyncConn:                      datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataSync])})),
-		DAXConn:                           dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DAX])})),
+		ACMConn:                           acm.New(sess.Copy(c.serviceConfig(ACM))),
+		ACMPCAConn:                        acmpca.New(sess.Copy(c.serviceConfig(ACMPCA))),
+		AMPConn:                           prometheusservice.New(sess.Copy(c.serviceConfig(AMP))),
+		AmplifyBackendConn:                amplifybackend.New(sess.Copy(c.serviceConfig(AmplifyBackend))),
+		AmplifyConn:                       amplify.New(sess.Copy(c.serviceConfig(Amplify))),
+		APIGatewayConn:                    apigateway.New(sess.Copy(c.serviceConfig(APIGateway))),
+		APIGatewayV2Conn:                  apigatewayv2.New(sess.Copy(c.serviceConfig(APIGatewayV2))),
+		AppAutoScalingConn:                applicationautoscaling.New(sess.Copy(c.serviceConfig(AppAutoScaling))),
+		AppConfigConn:                     appconfig.New(sess.Copy(c.serviceConfig(AppConfig))),
+		AppFlowConn:                       appflow.New(sess.Copy(c.serviceConfig(AppFlow))),
+		AppIntegrationsConn:               appintegrationsservice.New(sess.Copy(c.serviceConfig(AppIntegrations))),
+		ApplicationCostProfilerConn:       applicationcostprofiler.New(sess.Copy(c.serviceConfig(ApplicationCostProfiler))),
+		ApplicationDiscoveryConn:          applicationdiscoveryservice.New(sess.Copy(c.serviceConfig(ApplicationDiscovery))),
+		ApplicationInsightsConn:           applicationinsights.New(sess.Copy(c.serviceConfig(ApplicationInsights))),
+		AppMeshConn:                       appmesh.New(sess.Copy(c.serviceConfig(AppMesh))),
+		AppRegistryConn:                   appregistry.New(sess.Copy(c.serviceConfig(AppRegistry))),
+		AppRunnerConn:                     apprunner.New(sess.Copy(c.serviceConfig(AppRunner))),
+		AppStreamConn:                     appstream.New(sess.Copy(c.serviceConfig(AppStream))),
+		AppSyncConn:                       appsync.New(sess.Copy(c.serviceConfig(AppSync))),
+		AthenaConn:                        athena.New(sess.Copy(c.serviceConfig(Athena))),
+		AuditManagerConn:                  auditmanager.New(sess.Copy(c.serviceConfig(AuditManager))),
+		AugmentedAIRuntimeConn:            augmentedairuntime.New(sess.Copy(c.serviceConfig(AugmentedAIRuntime))),
+		AutoScalingConn:                   autoscaling.New(sess.Copy(c.serviceConfig(AutoScaling))),
+		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(c.serviceConfig(AutoScalingPlans))),
+		BackupConn:                        backup.New(sess.Copy(c.serviceConfig(Backup))),
+		BatchConn:                         batch.New(sess.Copy(c.serviceConfig(Batch))),
+		BraketConn:                        braket.New(sess.Copy(c.serviceConfig(Braket))),
+		BudgetsConn:                       budgets.New(sess.Copy(c.serviceConfig(Budgets))),
+		ChimeConn:                         chime.New(sess.Copy(c.serviceConfig(Chime))),
+		Cloud9Conn:                        cloud9.New(sess.Copy(c.serviceConfig(Cloud9))),
+		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(c.serviceConfig(CloudControl))),
+		CloudDirectoryConn:                clouddirectory.New(sess.Copy(c.serviceConfig(CloudDirectory))),
+		CloudFormationConn:                cloudformation.New(sess.Copy(c.serviceConfig(CloudFormation))),
+		CloudFrontConn:                    cloudfront.New(sess.Copy(c.serviceConfig(CloudFront))),
+		CloudHSMV2Conn:                    cloudhsmv2.New(sess.Copy(c.serviceConfig(CloudHSMV2))),
+		CloudSearchConn:                   cloudsearch.New(sess.Copy(c.serviceConfig(CloudSearch))),
+		CloudSearchDomainConn:             cloudsearchdomain.New(sess.Copy(c.serviceConfig(CloudSearchDomain))),
+		CloudTrailConn:                    cloudtrail.New(sess.Copy(c.serviceConfig(CloudTrail))),
+		CloudWatchConn:                    cloudwatch.New(sess.Copy(c.serviceConfig(CloudWatch))),
+		CloudWatchLogsConn:                cloudwatchlogs.New(sess.Copy(c.serviceConfig(CloudWatchLogs))),
+		CodeArtifactConn:                  codeartifact.New(sess.Copy(c.serviceConfig(CodeArtifact))),
+		CodeBuildConn:                     codebuild.New(sess.Copy(c.serviceConfig(CodeBuild))),
+		CodeCommitConn:                    codecommit.New(sess.Copy(c.serviceConfig(CodeCommit))),
+		CodeDeployConn:                    codedeploy.New(sess.Copy(c.serviceConfig(CodeDeploy))),
+		CodeGuruProfilerConn:              codeguruprofiler.New(sess.Copy(c.serviceConfig(CodeGuruProfiler))),
+		CodeGuruReviewerConn:              codegurureviewer.New(sess.Copy(c.serviceConfig(CodeGuruReviewer))),
+		CodePipelineConn:                  codepipeline.New(sess.Copy(c.serviceConfig(CodePipeline))),
+		CodeStarConn:                      codestar.New(sess.Copy(c.serviceConfig(CodeStar))),
+		CodeStarConnectionsConn:           codestarconnections.New(sess.Copy(c.serviceConfig(CodeStarConnections))),
+		CodeStarNotificationsConn:         codestarnotifications.New(sess.Copy(c.serviceConfig(CodeStarNotifications))),
+		CognitoIdentityConn:               cognitoidentity.New(sess.Copy(c.serviceConfig(CognitoIdentity))),
+		CognitoIDPConn:                    cognitoidentityprovider.New(sess.Copy(c.serviceConfig(CognitoIDP))),
+		CognitoSyncConn:                   cognitosync.New(sess.Copy(c.serviceConfig(CognitoSync))),
+		ComprehendConn:                    comprehend.New(sess.Copy(c.serviceConfig(Comprehend))),
+		ComprehendMedicalConn:             comprehendmedical.New(sess.Copy(c.serviceConfig(ComprehendMedical))),
+		ConfigServiceConn:                 configservice.New(sess.Copy(c.serviceConfig(ConfigService))),
+		ConnectConn:                       connect.New(sess.Copy(c.serviceConfig(Connect))),
+		ConnectContactLensConn:            connectcontactlens.New(sess.Copy(c.serviceConfig(ConnectContactLens))),
+		ConnectParticipantConn:            connectparticipant.New(sess.Copy(c.serviceConfig(ConnectParticipant))),
+		CostExplorerConn:                  costexplorer.New(sess.Copy(c.serviceConfig(CostExplorer))),
+		CURConn:                           costandusagereportservice.New(sess.Copy(c.serviceConfig(CUR))),
+		DataExchangeConn:                  dataexchange.New(sess.Copy(c.serviceConfig(DataExchange))),
+		DataPipelineConn:                  datapipeline.New(sess.Copy(c.serviceConfig(DataPipeline))),
+		DataSyncConn:                      datasync.New(sess.Copy(c.serviceConfig(DataSync))),
+		DAXConn:                           dax.New(sess.Copy(c.serviceConfig(DAX))),
 		DefaultTagsConfig:                 c.DefaultTagsConfig,
-		DetectiveConn:                     detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Detective])})),
-		DeviceFarmConn:                    devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DeviceFarm])})),
-		DevOpsGuruConn:                    devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DevOpsGuru])})),
-		DirectConnectConn:                 directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DirectConnect])})),
-		DLMConn:                           dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DLM])})),
-		DMSConn:                           databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DMS])})),
+		DetectiveConn:                     detective.New(sess.Copy(c.serviceConfig(Detective))),
+		DeviceFarmConn:                    devicefarm.New(sess.Copy(c.serviceConfig(DeviceFarm))),
+		DevOpsGuruConn:                    devopsguru.New(sess.Copy(c.serviceConfig(DevOpsGuru))),
+		DirectConnectConn:                 directconnect.New(sess.Copy(c.serviceConfig(DirectConnect))),
+		DLMConn:                           dlm.New(sess.Copy(c.serviceConfig(DLM))),
+		DMSConn:                           databasemigrationservice.New(sess.Copy(c.serviceConfig(DMS))),
 		DNSSuffix:                         DNSSuffix,
-		DocDBConn:                         docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DocDB])})),
-		DSConn:                            directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DS])})),
-		DynamoDBConn:                      dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDB])})),
-		DynamoDBStreamsConn:               dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDBStreams])})),
-		EC2Conn:                           ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2])})),
-		EC2InstanceConnectConn:            ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2InstanceConnect])})),
-		ECRConn:                           ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECR])})),
-		ECRPublicConn:                     ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECRPublic])})),
-		ECSConn:                           ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECS])})),
-		EFSConn:                           efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EFS])})),
-		EKSConn:                           eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EKS])})),
-		ElastiCacheConn:                   elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElastiCache])})),
-		ElasticBeanstalkConn:              elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticBeanstalk])})),
-		ElasticInferenceConn:              elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticInference])})),
-		ElasticsearchConn:                 elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Elasticsearch])})),
-		ElasticTranscoderConn:             elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticTranscoder])})),
-		ELBConn:                           elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELB])})),
-		ELBV2Conn:                         elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELBV2])})),
-		EMRConn:                           emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMR])})),
-		EMRContainersConn:                 emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMRContainers])})),
-		EventsConn:                        eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Events])})),
-		FinSpaceConn:                      finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpace])})),
-		FinSpaceDataConn:                  finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpaceData])})),
-		FirehoseConn:                      firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Firehose])})),
-		FISConn:                           fis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FIS])})),
-		FMSConn:                           fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FMS])})),
-		ForecastConn:                      forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Forecast])})),
-		ForecastQueryConn:                 forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ForecastQuery])})),
-		FraudDetectorConn:                 frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FraudDetector])})),
-		FSxConn:                           fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FSx])})),
-		GameLiftConn:                      gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GameLift])})),
-		GlacierConn:                       glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glacier])})),
-		GlueConn:                          glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glue])})),
-		GlueDataBrewConn:                  gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GlueDataBrew])})),
-		GreengrassConn:                    greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Greengrass])})),
-		GreengrassV2Conn:                  greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GreengrassV2])})),
-		GroundStationConn:                 groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GroundStation])})),
-		GuardDutyConn:                     guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GuardDuty])})),
-		HealthConn:                        health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Health])})),
-		HealthLakeConn:                    healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[HealthLake])})),
-		HoneycodeConn:                     honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Honeycode])})),
-		IAMConn:                           iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IAM])})),
-		IdentityStoreConn:                 identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IdentityStore])})),
+		DocDBConn:                         docdb.New(sess.Copy(c.serviceConfig(DocDB))),
+		DSConn:                            directoryservice.New(sess.Copy(c.serviceConfig(DS))),
+		DynamoDBConn:                      dynamodb.New(sess.Copy(c.serviceConfig(DynamoDB))),
+		DynamoDBStreamsConn:               dynamodbstreams.New(sess.Copy(c.serviceConfig(DynamoDBStreams))),
+		EC2Conn:                           ec2.New(sess.Copy(c.serviceConfig(EC2))),
+		EC2InstanceConnectConn:            ec2instanceconnect.New(sess.Copy(c.serviceConfig(EC2InstanceConnect))),
+		ECRConn:                           ecr.New(sess.Copy(c.serviceConfig(ECR))),
+		ECRPublicConn:                     ecrpublic.New(sess.Copy(c.serviceConfig(ECRPublic))),
+		ECSConn:                           ecs.New(sess.Copy(c.serviceConfig(ECS))),
+		EFSConn:                           efs.New(sess.Copy(c.serviceConfig(EFS))),
+		EKSConn:                           eks.New(sess.Copy(c.serviceConfig(EKS))),
+		ElastiCacheConn:                   elasticache.New(sess.Copy(c.serviceConfig(ElastiCache))),
+		ElastiCacheDefaultDescription:     c.ElastiCacheDefaultDescription,
+		ElastiCacheValidateParameters:     c.ElastiCacheValidateParameters,
+		ElasticBeanstalkConn:              elasticbeanstalk.New(sess.Copy(c.serviceConfig(ElasticBeanstalk))),
+		ElasticInferenceConn:              elasticinference.New(sess.Copy(c.serviceConfig(ElasticInference))),
+		ElasticsearchConn:                 elasticsearch.New(sess.Copy(c.serviceConfig(Elasticsearch))),
+		ElasticTranscoderConn:             elastictranscoder.New(sess.Copy(c.serviceConfig(ElasticTranscoder))),
+		ELBConn:                           elb.New(sess.Copy(c.serviceConfig(ELB))),
+		ELBV2Conn:                         elbv2.New(sess.Copy(c.serviceConfig(ELBV2))),
+		EMRConn:                           emr.New(sess.Copy(c.serviceConfig(EMR))),
+		EMRContainersConn:                 emrcontainers.New(sess.Copy(c.serviceConfig(EMRContainers))),
+		EventsConn:                        eventbridge.New(sess.Copy(c.serviceConfig(Events))),
+		FinSpaceConn:                      finspace.New(sess.Copy(c.serviceConfig(FinSpace))),
+		FinSpaceDataConn:                  finspacedata.New(sess.Copy(c.serviceConfig(FinSpaceData))),
+		FirehoseConn:                      firehose.New(sess.Copy(c.serviceConfig(Firehose))),
+		FISConn:                           fis.New(sess.Copy(c.serviceConfig(FIS))),
+		FMSConn:                           fms.New(sess.Copy(c.serviceConfig(FMS))),
+		ForecastConn:                      forecastservice.New(sess.Copy(c.serviceConfig(Forecast))),
+		ForecastQueryConn:                 forecastqueryservice.New(sess.Copy(c.serviceConfig(ForecastQuery))),
+		FraudDetectorConn:                 frauddetector.New(sess.Copy(c.serviceConfig(FraudDetector))),
+		FSxConn:                           fsx.New(sess.Copy(c.serviceConfig(FSx))),
+		GameLiftConn:                      gamelift.New(sess.Copy(c.serviceConfig(GameLift))),
+		GlacierConn:                       glacier.New(sess.Copy(c.serviceConfig(Glacier))),
+		GlueConn:                          glue.New(sess.Copy(c.serviceConfig(Glue))),
+		GlueDataBrewConn:                  gluedatabrew.New(sess.Copy(c.serviceConfig(GlueDataBrew))),
+		GreengrassConn:                    greengrass.New(sess.Copy(c.serviceConfig(Greengrass))),
+		GreengrassV2Conn:                  greengrassv2.New(sess.Copy(c.serviceConfig(GreengrassV2))),
+		GroundStationConn:                 groundstation.New(sess.Copy(c.serviceConfig(GroundStation))),
+		GuardDutyConn:                     guardduty.New(sess.Copy(c.serviceConfig(GuardDuty))),
+		HealthConn:                        health.New(sess.Copy(c.serviceConfig(Health))),
+		HealthLakeConn:                    healthlake.New(sess.Copy(c.serviceConfig(HealthLake))),
+		IAMConn:                           iam.New(sess.Copy(c.serviceConfig(IAM))),
+		IdentityStoreConn:                 identitystore.New(sess.Copy(c.serviceConfig(IdentityStore))),
 		IgnoreTagsConfig:                  c.IgnoreTagsConfig,
-		ImageBuilderConn:                  imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ImageBuilder])})),
-		InspectorConn:                     inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Inspector])})),
-		IoT1ClickDevicesConn:              iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickDevices])})),
-		IoT1ClickProjectsConn:             iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickProjects])})),
-		IoTAnalyticsConn:                  iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTAnalytics])})),
-		IoTConn:                           iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT])})),
-		IoTDataPlaneConn:                  iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDataPlane])})),
-		IoTDeviceAdvisorConn:              iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDeviceAdvisor])})),
-		IoTEventsConn:                     iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEvents])})),
-		IoTEventsDataConn:                 ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEventsData])})),
-		IoTFleetHubConn:                   iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTFleetHub])})),
-		IoTJobsDataPlaneConn:              iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTJobsDataPlane])})),
-		IoTSecureTunnelingConn:            iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSecureTunneling])})),
-		IoTSiteWiseConn:                   iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSiteWise])})),
-		IoTThingsGraphConn:                iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTThingsGraph])})),
-		IoTWirelessConn:                   iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTWireless])})),
-		KafkaConn:                         kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kafka])})),
-		KafkaConnectConn:                  kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KafkaConnect])})),
-		KendraConn:                        kendra.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kendra])})),
-		KinesisAnalyticsConn:              kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalytics])})),
-		KinesisAnalyticsV2Conn:            kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalyticsV2])})),
-		KinesisConn:                       kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kinesis])})),
-		KinesisVideoArchivedMediaConn:     kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoArchivedMedia])})),
-		KinesisVideoConn:                  kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideo])})),
-		KinesisVideoMediaConn:             kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoMedia])})),
-		KinesisVideoSignalingChannelsConn: kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoSignalingChannels])})),
-		KMSConn:                           kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KMS])})),
-		LakeFormationConn:                 lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LakeFormation])})),
-		LambdaConn:                        lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lambda])})),
-		LexModelsConn:                     lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModels])})),
-		LexModelsV2Conn:                   lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModelsV2])})),
-		LexRuntimeConn:                    lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntime])})),
-		LexRuntimeV2Conn:                  lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntimeV2])})),
-		LicenseManagerConn:                licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LicenseManager])})),
-		LightsailConn:                     lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lightsail])})),
-		LocationConn:                      locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Location])})),
-		LookoutEquipmentConn:              lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutEquipment])})),
-		LookoutForVisionConn:              lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutForVision])})),
-		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutMetrics])})),
-		MachineLearningConn:               machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MachineLearning])})),
-		Macie2Conn:                        macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie2])})),
-		MacieConn:                         macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie])})),
-		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ManagedBlockchain])})),
-		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCatalog])})),
-		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCommerceAnalytics])})),
-		MarketplaceEntitlementConn:        marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceEntitlement])})),
-		MarketplaceMeteringConn:           marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceMetering])})),
-		MediaConnectConn:                  mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConnect])})),
-		MediaConvertConn:                  mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConvert])})),
-		MediaLiveConn:                     medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaLive])})),
-		MediaPackageConn:                  mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackage])})),
-		MediaPackageVODConn:               mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackageVOD])})),
-		MediaStoreConn:                    mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStore])})),
-		MediaStoreDataConn:                mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStoreData])})),
-		MediaTailorConn:                   mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaTailor])})),
-		MemoryDBConn:                      memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MemoryDB])})),
-		MgnConn:                           mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mgn])})),
-		MigrationHubConfigConn:            migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHubConfig])})),
-		MigrationHubConn:                  migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHub])})),
-		MobileAnalyticsConn:               mobileanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MobileAnalytics])})),
-		MobileConn:                        mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mobile])})),
-		MQConn:                            mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MQ])})),
-		MTurkConn:                         mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MTurk])})),
-		MWAAConn:                          mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MWAA])})),
-		NeptuneConn:                       neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Neptune])})),
-		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
-		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
-		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
-		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
-		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
-		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
-		OutpostsConn:                      outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Outposts])})),
+		ImageBuilderConn:                  imagebuilder.New(sess.Copy(c.serviceConfig(ImageBuilder))),
+		InspectorConn:                     inspector.New(sess.Copy(c.serviceConfig(Inspector))),
+		IoT1ClickDevicesConn:              iot1clickdevicesservice.New(sess.Copy(c.serviceConfig(IoT1ClickDevices))),
+		IoT1ClickProjectsConn:             iot1clickprojects.New(sess.Copy(c.serviceConfig(IoT1ClickProjects))),
+		IoTAnalyticsConn:                  iotanalytics.New(sess.Copy(c.serviceConfig(IoTAnalytics))),
+		IoTConn:                           iot.New(sess.Copy(c.serviceConfig(IoT))),
+		IoTDataPlaneConn:                  iotdataplane.New(sess.Copy(c.serviceConfig(IoTDataPlane))),
+		IoTDeviceAdvisorConn:              iotdeviceadvisor.New(sess.Copy(c.serviceConfig(IoTDeviceAdvisor))),
+		IoTEventsConn:                     iotevents.New(sess.Copy(c.serviceConfig(IoTEvents))),
+		IoTEventsDataConn:                 ioteventsdata.New(sess.Copy(c.serviceConfig(IoTEventsData))),
+		IoTFleetHubConn:                   iotfleethub.New(sess.Copy(c.serviceConfig(IoTFleetHub))),
+		IoTJobsDataPlaneConn:              iotjobsdataplane.New(sess.Copy(c.serviceConfig(IoTJobsDataPlane))),
+		IoTSecureTunnelingConn:            iotsecuretunneling.New(sess.Copy(c.serviceConfig(IoTSecureTunneling))),
+		IoTSiteWiseConn:                   iotsitewise.New(sess.Copy(c.serviceConfig(IoTSiteWise))),
+		IoTThingsGraphConn:                iotthingsgraph.New(sess.Copy(c.serviceConfig(IoTThingsGraph))),
+		IoTWirelessConn:                   iotwireless.New(sess.Copy(c.serviceConfig(IoTWireless))),
+		KafkaConn:                         kafka.New(sess.Copy(c.serviceConfig(Kafka))),
+		KafkaConnectConn:                  kafkaconnect.New(sess.Copy(c.serviceConfig(KafkaConnect))),
+		KendraConn:                        kendra.New(sess.Copy(c.serviceConfig(Kendra))),
+		KinesisAnalyticsConn:              kinesisanalytics.New(sess.Copy(c.serviceConfig(KinesisAnalytics))),
+		KinesisAnalyticsV2Conn:            kinesisanalyticsv2.New(sess.Copy(c.serviceConfig(KinesisAnalyticsV2))),
+		KinesisConn:                       kinesis.New(sess.Copy(c.serviceConfig(Kinesis))),
+		KinesisVideoArchivedMediaConn:     kinesisvideoarchivedmedia.New(sess.Copy(c.serviceConfig(KinesisVideoArchivedMedia))),
+		KinesisVideoConn:                  kinesisvideo.New(sess.Copy(c.serviceConfig(KinesisVideo))),
+		KinesisVideoMediaConn:             kinesisvideomedia.New(sess.Copy(c.serviceConfig(KinesisVideoMedia))),
+		KinesisVideoSignalingChannelsConn: kinesisvideosignalingchannels.New(sess.Copy(c.serviceConfig(KinesisVideoSignalingChannels))),
+		KMSConn:                           kms.New(sess.Copy(c.serviceConfig(KMS))),
+		LakeFormationConn:                 lakeformation.New(sess.Copy(c.serviceConfig(LakeFormation))),
+		LambdaConn:                        lambda.New(sess.Copy(c.serviceConfig(Lambda))),
+		LexModelsConn:                     lexmodelbuildingservice.New(sess.Copy(c.serviceConfig(LexModels))),
+		LexModelsV2Conn:                   lexmodelsv2.New(sess.Copy(c.serviceConfig(LexModelsV2))),
+		LexRuntimeConn:                    lexruntimeservice.New(sess.Copy(c.serviceConfig(LexRuntime))),
+		LexRuntimeV2Conn:                  lexruntimev2.New(sess.Copy(c.serviceConfig(LexRuntimeV2))),
+		LicenseManagerConn:                licensemanager.New(sess.Copy(c.serviceConfig(LicenseManager))),
+		LightsailConn:                     lightsail.New(sess.Copy(c.serviceConfig(Lightsail))),
+		LocationConn:                      locationservice.New(sess.Copy(c.serviceConfig(Location))),
+		LookoutEquipmentConn:              lookoutequipment.New(sess.Copy(c.serviceConfig(LookoutEquipment))),
+		LookoutForVisionConn:              lookoutforvision.New(sess.Copy(c.serviceConfig(LookoutForVision))),
+		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(c.serviceConfig(LookoutMetrics))),
+		MachineLearningConn:               machinelearning.New(sess.Copy(c.serviceConfig(MachineLearning))),
+		Macie2Conn:                        macie2.New(sess.Copy(c.serviceConfig(Macie2))),
+		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(c.serviceConfig(ManagedBlockchain))),
+		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(c.serviceConfig(MarketplaceCatalog))),
+		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(c.serviceConfig(MarketplaceCommerceAnalytics))),
+		MarketplaceEntitlementConn:        marketplaceentitlementservice.New(sess.Copy(c.serviceConfig(MarketplaceEntitlement))),
+		MarketplaceMeteringConn:           marketplacemetering.New(sess.Copy(c.serviceConfig(MarketplaceMetering))),
+		MediaConnectConn:                  mediaconnect.New(sess.Copy(c.serviceConfig(MediaConnect))),
+		MediaConvertConn:                  mediaconvert.New(sess.Copy(c.serviceConfig(MediaConvert))),
+		MediaLiveConn:                     medialive.New(sess.Copy(c.serviceConfig(MediaLive))),
+		MediaPackageConn:                  mediapackage.New(sess.Copy(c.serviceConfig(MediaPackage))),
+		MediaPackageVODConn:               mediapackagevod.New(sess.Copy(c.serviceConfig(MediaPackageVOD))),
+		MediaStoreConn:                    mediastore.New(sess.Copy(c.serviceConfig(MediaStore))),
+		MediaStoreDataConn:                mediastoredata.New(sess.Copy(c.serviceConfig(MediaStoreData))),
+		MediaTailorConn:                   mediatailor.New(sess.Copy(c.serviceConfig(MediaTailor))),
+		MemoryDBConn:                      memorydb.New(sess.Copy(c.serviceConfig(MemoryDB))),
+		MgnConn:                           mgn.New(sess.Copy(c.serviceConfig(Mgn))),
+		MigrationHubConfigConn:            migrationhubconfig.New(sess.Copy(c.serviceConfig(MigrationHubConfig))),
+		MigrationHubConn:                  migrationhub.New(sess.Copy(c.serviceConfig(MigrationHub))),
+		MobileAnalyticsConn:               mobileanalytics.New(sess.Copy(c.serviceConfig(MobileAnalytics))),
+		MQConn:                            mq.New(sess.Copy(c.serviceConfig(MQ))),
+		MTurkConn:                         mturk.New(sess.Copy(c.serviceConfig(MTurk))),
+		MWAAConn:                          mwaa.New(sess.Copy(c.serviceConfig(MWAA))),
+		NeptuneConn:                       neptune.New(sess.Copy(c.serviceConfig(Neptune))),
+		NetworkFirewallConn:               networkfirewall.New(sess.Copy(c.serviceConfig(NetworkFirewall))),
+		NetworkManagerConn:                networkmanager.New(sess.Copy(c.serviceConfig(NetworkManager))),
+		NimbleStudioConn:                  nimblestudio.New(sess.Copy(c.serviceConfig(NimbleStudio))),
+		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(c.serviceConfig(OpsWorksCM))),
+		OpsWorksConn:                      opsworks.New(sess.Copy(c.serviceConfig(OpsWorks))),
+		OrganizationsConn:                 organizations.New(sess.Copy(c.serviceConfig(Organizations))),
+		OutpostsConn:                      outposts.New(sess.Copy(c.serviceConfig(Outposts))),
 		Partition:                         Partition,
-		PersonalizeConn:                   personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Personalize])})),
-		PersonalizeEventsConn:             personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeEvents])})),
-		PersonalizeRuntimeConn:            personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeRuntime])})),
-		PIConn:                            pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PI])})),
-		PinpointConn:                      pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pinpoint])})),
-		PinpointEmailConn:                 pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointEmail])})),
-		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointSMSVoice])})),
-		PollyConn:                         polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Polly])})),
-		PricingConn:                       pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pricing])})),
-		ProtonConn:                        proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Proton])})),
-		QLDBConn:                          qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDB])})),
-		QLDBSessionConn:                   qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDBSession])})),
-		QuickSightConn:                    quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QuickSight])})),
-		RAMConn:                           ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RAM])})),
-		RDSConn:                           rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDS])})),
-		RDSDataConn:                       rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDSData])})),
-		RedshiftConn:                      redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Redshift])})),
-		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftData])})),
+		PersonalizeConn:                   personalize.New(sess.Copy(c.serviceConfig(Personalize))),
+		PersonalizeEventsConn:             personalizeevents.New(sess.Copy(c.serviceConfig(PersonalizeEvents))),
+		PersonalizeRuntimeConn:            personalizeruntime.New(sess.Copy(c.serviceConfig(PersonalizeRuntime))),
+		PIConn:                            pi.New(sess.Copy(c.serviceConfig(PI))),
+		PinpointConn:                      pinpoint.New(sess.Copy(c.serviceConfig(Pinpoint))),
+		PinpointEmailConn:                 pinpointemail.New(sess.Copy(c.serviceConfig(PinpointEmail))),
+		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(c.serviceConfig(PinpointSMSVoice))),
+		PollyConn:                         polly.New(sess.Copy(c.serviceConfig(Polly))),
+		PricingConn:                       pricing.New(sess.Copy(c.serviceConfig(Pricing))),
+		ProtonConn:                        proton.New(sess.Copy(c.serviceConfig(Proton))),
+		QLDBConn:                          qldb.New(sess.Copy(c.serviceConfig(QLDB))),
+		QLDBSessionConn:                   qldbsession.New(sess.Copy(c.serviceConfig(QLDBSession))),
+		QuickSightConn:                    quicksight.New(sess.Copy(c.serviceConfig(QuickSight))),
+		RAMConn:                           ram.New(sess.Copy(c.serviceConfig(RAM))),
+		RDSConn:                           rds.New(sess.Copy(c.serviceConfig(RDS))),
+		RDSDataConn:                       rdsdataservice.New(sess.Copy(c.serviceConfig(RDSData))),
+		RedshiftConn:                      redshift.New(sess.Copy(c.serviceConfig(Redshift))),
+		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(c.serviceConfig(RedshiftData))),
+		RedshiftServerlessConn:            redshiftserverless.New(sess.Copy(c.serviceConfig(RedshiftServerless))),
 		Region:                            c.Region,
-		RekognitionConn:                   rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Rekognition])})),
-		ResourceGroupsConn:                resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroups])})),
-		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
+		RekognitionConn:                   rekognition.New(sess.Copy(c.serviceConfig(Rekognition))),
+		ResourceGroupsConn:                resourcegroups.New(sess.Copy(c.serviceConfig(ResourceGroups))),
+		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(c.serviceConfig(ResourceGroupsTaggingAPI))),
 		ReverseDNSPrefix:                  ReverseDNS(DNSSuffix),
-		RoboMakerConn:                     robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RoboMaker])})),
-		Route53DomainsConn:                route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Domains])})),
-		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig])})),
-		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness])})),
-		Route53ResolverConn:               route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Resolver])})),
-		S3ControlConn:                     s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Control])})),
-		S3OutpostsConn:                    s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Outposts])})),
-		SageMakerConn:                     sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMaker])})),
-		SageMakerEdgeManagerConn:          sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerEdgeManager])})),
-		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerFeatureStoreRuntime])})),
-		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerRuntime])})),
-		SavingsPlansConn:                  savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SavingsPlans])})),
-		SchemasConn:                       schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Schemas])})),
-		SecretsManagerConn:                secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecretsManager])})),
-		SecurityHubConn:                   securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecurityHub])})),
-		ServerlessRepoConn:                serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServerlessRepo])})),
-		ServiceCatalogConn:                servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceCatalog])})),
-		ServiceDiscoveryConn:              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceDiscovery])})),
-		ServiceQuotasConn:                 servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceQuotas])})),
-		SESConn:                           ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SES])})),
-		SESV2Conn:                         sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SESV2])})),
-		SFNConn:                           sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SFN])})),
-		SignerConn:                        signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Signer])})),
-		SimpleDBConn:                      simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SimpleDB])})),
-		SMSConn:                           sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SMS])})),
-		SnowballConn:                      snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Snowball])})),
-		SNSConn:                           sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SNS])})),
-		SQSConn:                           sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SQS])})),
-		SSMConn:                           ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSM])})),
-		SSMContactsConn:                   ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMContacts])})),
-		SSMIncidentsConn:                  ssmincidents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMIncidents])})),
-		SSOAdminConn:                      ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOAdmin])})),
-		SSOConn:                           sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSO])})),
-		SSOOIDCConn:                       ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOOIDC])})),
-		StorageGatewayConn:                storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[StorageGateway])})),
-		STSConn:                           sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[STS])})),
-		SupportConn:                       support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
-		SWFConn:                           swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
-		SyntheticsConn:                    synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
+		RoboMakerConn:                     robomaker.New(sess.Copy(c.serviceConfig(RoboMaker))),
+		RolesAnywhereConn:                 rolesanywhere.New(sess.Copy(c.serviceConfig(RolesAnywhere))),
+		Route53DomainsConn:                route53domains.New(sess.Copy(c.serviceConfig(Route53Domains))),
+		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(c.serviceConfig(Route53RecoveryControlConfig))),
+		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(c.serviceConfig(Route53RecoveryReadiness))),
+		Route53ResolverConn:               route53resolver.New(sess.Copy(c.serviceConfig(Route53Resolver))),
+		S3ControlConn:                     s3control.New(sess.Copy(c.serviceConfig(S3Control))),
+		S3OutpostsConn:                    s3outposts.New(sess.Copy(c.serviceConfig(S3Outposts))),
+		SageMakerConn:                     sagemaker.New(sess.Copy(c.serviceConfig(SageMaker))),
+		SageMakerEdgeManagerConn:          sagemakeredgemanager.New(sess.Copy(c.serviceConfig(SageMakerEdgeManager))),
+		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(c.serviceConfig(SageMakerFeatureStoreRuntime))),
+		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(c.serviceConfig(SageMakerRuntime))),
+		SavingsPlansConn:                  savingsplans.New(sess.Copy(c.serviceConfig(SavingsPlans))),
+		SchemasConn:                       schemas.New(sess.Copy(c.serviceConfig(Schemas))),
+		SecretsManagerConn:                secretsmanager.New(sess.Copy(c.serviceConfig(SecretsManager))),
+		SecurityHubConn:                   securityhub.New(sess.Copy(c.serviceConfig(SecurityHub))),
+		ServerlessRepoConn:                serverlessapplicationrepository.New(sess.Copy(c.serviceConfig(ServerlessRepo))),
+		ServiceCatalogConn:                servicecatalog.New(sess.Copy(c.serviceConfig(ServiceCatalog))),
+		ServiceDiscoveryConn:              servicediscovery.New(sess.Copy(c.serviceConfig(ServiceDiscovery))),
+		ServiceQuotasConn:                 servicequotas.New(sess.Copy(c.serviceConfig(ServiceQuotas))),
+		SESConn:                           ses.New(sess.Copy(c.serviceConfig(SES))),
+		SESV2Conn:                         sesv2.New(sess.Copy(c.serviceConfig(SESV2))),
+		SFNConn:                           sfn.New(sess.Copy(c.serviceConfig(SFN))),
+		SignerConn:                        signer.New(sess.Copy(c.serviceConfig(Signer))),
+		SimpleDBConn:                      simpledb.New(sess.Copy(c.serviceConfig(SimpleDB))),
+		SMSConn:                           sms.New(sess.Copy(c.serviceConfig(SMS))),
+		SnowballConn:                      snowball.New(sess.Copy(c.serviceConfig(Snowball))),
+		SNSConn:                           sns.New(sess.Copy(c.serviceConfig(SNS))),
+		SQSConn:                           sqs.New(sess.Copy(c.serviceConfig(SQS))),
+		SSMConn:                           ssm.New(sess.Copy(c.serviceConfig(SSM))),
+		SSMContactsConn:                   ssmcontacts.New(sess.Copy(c.serviceConfig(SSMContacts))),
+		SSMIncidentsConn:                  ssmincidents.New(sess.Copy(c.serviceConfig(SSMIncidents))),
+		SSOAdminConn:                      ssoadmin.New(sess.Copy(c.serviceConfig(SSOAdmin))),
+		SSOConn:                           sso.New(sess.Copy(c.serviceConfig(SSO))),
+		SSOOIDCConn:                       ssooidc.New(sess.Copy(c.serviceConfig(SSOOIDC))),
+		StorageGatewayConn:                storagegateway.New(sess.Copy(c.serviceConfig(StorageGateway))),
+		STSConn:                           sts.New(sess.Copy(c.serviceConfig(STS))),
+		SupportConn:                       support.New(sess.Copy(c.serviceConfig(Support))),
+		SWFConn:                           swf.New(sess.Copy(c.serviceConfig(SWF))),
+		SyntheticsConn:                    synthetics.New(sess.Copy(c.serviceConfig(Synthetics))),
 		TerraformVersion:                  c.TerraformVersion,
-		TextractConn:                      textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
-		TimestreamQueryConn:               timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
-		TimestreamWriteConn:               timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamWrite])})),
-		TranscribeConn:                    transcribeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transcribe])})),
-		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TranscribeStreaming])})),
-		TransferConn:                      transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transfer])})),
-		TranslateConn:                     translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Translate])})),
-		WAFConn:                           waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAF])})),
-		WAFRegionalConn:                   wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFRegional])})),
-		WAFV2Conn:                         wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFV2])})),
-		WellArchitectedConn:               wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WellArchitected])})),
-		WorkDocsConn:                      workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkDocs])})),
-		WorkLinkConn:                      worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkLink])})),
-		WorkMailConn:                      workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMail])})),
-		WorkMailMessageFlowConn:           workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMailMessageFlow])})),
-		WorkSpacesConn:                    workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkSpaces])})),
-		XRayConn:                          xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[XRay])})),
+		TextractConn:                      textract.New(sess.Copy(c.serviceConfig(Textract))),
+		TimestreamQueryConn:               timestreamquery.New(sess.Copy(c.serviceConfig(TimestreamQuery))),
+		TimestreamWriteConn:               timestreamwrite.New(sess.Copy(c.serviceConfig(TimestreamWrite))),
+		TranscribeConn:                    transcribeservice.New(sess.Copy(c.serviceConfig(Transcribe))),
+		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(c.serviceConfig(TranscribeStreaming))),
+		TransferConn:                      transfer.New(sess.Copy(c.serviceConfig(Transfer))),
+		TranslateConn:                     translate.New(sess.Copy(c.serviceConfig(Translate))),
+		VPCLatticeConn:                    vpclattice.New(sess.Copy(c.serviceConfig(VPCLattice))),
+		WAFConn:                           waf.New(sess.Copy(c.serviceConfig(WAF))),
+		WAFRegionalConn:                   wafregional.New(sess.Copy(c.serviceConfig(WAFRegional))),
+		WAFV2Conn:                         wafv2.New(sess.Copy(c.serviceConfig(WAFV2))),
+		WellArchitectedConn:               wellarchitected.New(sess.Copy(c.serviceConfig(WellArchitected))),
+		WorkDocsConn:                      workdocs.New(sess.Copy(c.serviceConfig(WorkDocs))),
+		WorkLinkConn:                      worklink.New(sess.Copy(c.serviceConfig(WorkLink))),
+		WorkMailConn:                      workmail.New(sess.Copy(c.serviceConfig(WorkMail))),
+		WorkMailMessageFlowConn:           workmailmessageflow.New(sess.Copy(c.serviceConfig(WorkMailMessageFlow))),
+		WorkSpacesConn:                    workspaces.New(sess.Copy(c.serviceConfig(WorkSpaces))),
+		XRayConn:                          xray.New(sess.Copy(c.serviceConfig(XRay))),
 	}
 
 	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[GlobalAccelerator]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53]),
-	}
-	route53RecoveryControlConfigConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig]),
-	}
-	route53RecoveryReadinessConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Shield]),
-	}
+	globalAcceleratorConfig := c.serviceConfig(GlobalAccelerator)
+	route53Config := c.serviceConfig(Route53)
+	route53RecoveryControlConfigConfig := c.serviceConfig(Route53RecoveryControlConfig)
+	route53RecoveryReadinessConfig := c.serviceConfig(Route53RecoveryReadiness)
+	shieldConfig := c.serviceConfig(Shield)
 
 	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints[S3]),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
-	}
+	s3Config := c.serviceConfig(S3)
+	s3Config.S3ForcePathStyle = aws.Bool(c.S3ForcePathStyle)
 
 	client.S3Conn = s3.New(sess.Copy(s3Config))
 
@@ -1817,6 +1905,163 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// endpoint returns the custom endpoint for the given service, falling back to
+// the endpoint URL configured for all services.
+func (c *Config) endpoint(service string) string {
+	if v := c.Endpoints[service]; v != "" {
+		return v
+	}
+
+	return c.EndpointURL
+}
+
+// serviceConfig returns the client configuration for the given service,
+// applying any custom endpoint and max retries override.
+func (c *Config) serviceConfig(service string) *aws.Config {
+	config := &aws.Config{
+		Endpoint: aws.String(c.endpoint(service)),
+	}
+
+	if v, ok := c.ServiceMaxRetries[service]; ok {
+		config.MaxRetries = aws.Int(v)
+	}
+
+	return config
+}
+
+// webIdentityCredentials returns credentials obtained by calling sts:AssumeRoleWithWebIdentity
+// with the configured web identity token, either read from a file on each refresh or given inline.
+func (c *Config) webIdentityCredentials() (*credentials.Credentials, error) {
+	if c.AssumeRoleWithWebIdentityToken == "" && c.AssumeRoleWithWebIdentityTokenFile == "" {
+		return nil, fmt.Errorf("one of web_identity_token or web_identity_token_file must be set to assume role (%s) with web identity", c.AssumeRoleWithWebIdentityARN)
+	}
+
+	awsConfig := &aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.endpoint(STS)),
+		HTTPClient:  cleanhttp.DefaultClient(),
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
+	}
+
+	transport := awsConfig.HTTPClient.Transport.(*http.Transport)
+
+	if c.Insecure {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating web identity session: %w", err)
+	}
+
+	var tokenFetcher stscreds.TokenFetcher = stscreds.FetchTokenPath(c.AssumeRoleWithWebIdentityTokenFile)
+
+	if c.AssumeRoleWithWebIdentityToken != "" {
+		tokenFetcher = webIdentityToken(c.AssumeRoleWithWebIdentityToken)
+	}
+
+	sessionName := c.AssumeRoleWithWebIdentitySessionName
+
+	if sessionName == "" {
+		sessionName = resource.PrefixedUniqueId("terraform-")
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithOptions(sts.New(sess), c.AssumeRoleWithWebIdentityARN, sessionName, tokenFetcher, func(p *stscreds.WebIdentityRoleProvider) {
+		if c.AssumeRoleWithWebIdentityDurationSeconds > 0 {
+			p.Duration = time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second
+		}
+	})
+
+	return credentials.NewCredentials(provider), nil
+}
+
+// assumeRole returns the first role of the assume_role configuration.
+func (c *Config) assumeRole() *AssumeRole {
+	return &AssumeRole{
+		RoleARN:           c.AssumeRoleARN,
+		DurationSeconds:   c.AssumeRoleDurationSeconds,
+		ExternalID:        c.AssumeRoleExternalID,
+		Policy:            c.AssumeRolePolicy,
+		PolicyARNs:        c.AssumeRolePolicyARNs,
+		SessionName:       c.AssumeRoleSessionName,
+		Tags:              c.AssumeRoleTags,
+		TransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
+	}
+}
+
+// assumeRoleCredentials returns credentials for the given role,
+// obtained with the credentials of the given session.
+func (c *Config) assumeRoleCredentials(sess *session.Session, role *AssumeRole) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", role.RoleARN, role.SessionName, role.ExternalID)
+
+	conn := sts.New(sess.Copy(c.serviceConfig(STS)))
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  conn,
+		RoleARN: role.RoleARN,
+	}
+
+	if role.DurationSeconds > 0 {
+		provider.Duration = time.Duration(role.DurationSeconds) * time.Second
+	}
+
+	if role.ExternalID != "" {
+		provider.ExternalID = aws.String(role.ExternalID)
+	}
+
+	if role.Policy != "" {
+		provider.Policy = aws.String(role.Policy)
+	}
+
+	for _, policyARN := range role.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if role.SessionName != "" {
+		provider.RoleSessionName = role.SessionName
+	}
+
+	for k, v := range role.Tags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(role.TransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(role.TransitiveTagKeys)
+	}
+
+	creds := credentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming role (%s): %w", role.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+// webIdentityToken is a stscreds.TokenFetcher for a web identity token provided inline.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
 func StdUserAgentProducts(terraformVersion string) []*awsbase.UserAgentProduct {
 	return []*awsbase.UserAgentProduct{
 		{Name: "APN", Version: "1.0"},
@@ -1,9 +1,14 @@
 package conns
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
@@ -114,6 +119,188 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestWebIdentityCredentials(t *testing.T) {
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte(awsbase.MockWebIdentityToken), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		ExpectError bool
+	}{
+		{
+			Name: "token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+		},
+		{
+			Name: "token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+			},
+		},
+		{
+			Name: "no token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config.Endpoints = map[string]string{STS: ts.URL}
+			testCase.Config.Region = "us-east-1" //lintignore:AWSAT003
+
+			creds, err := testCase.Config.webIdentityCredentials()
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error retrieving credentials: %s", err)
+			}
+
+			if value.AccessKeyID != awsbase.MockStsAssumeRoleWithWebIdentityAccessKey {
+				t.Errorf("got access key %q, expected %q", value.AccessKeyID, awsbase.MockStsAssumeRoleWithWebIdentityAccessKey)
+			}
+
+			if value.SessionToken != awsbase.MockStsAssumeRoleWithWebIdentitySessionToken {
+				t.Errorf("got session token %q, expected %q", value.SessionToken, awsbase.MockStsAssumeRoleWithWebIdentitySessionToken)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleCredentialsWithWebIdentity(t *testing.T) {
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpoint,
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	config := &Config{
+		AssumeRoleARN:                        awsbase.MockStsAssumeRoleArn,
+		AssumeRoleDurationSeconds:            900,
+		AssumeRoleSessionName:                awsbase.MockStsAssumeRoleSessionName,
+		AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+		AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+		AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+		Endpoints:                            map[string]string{STS: ts.URL},
+		Region:                               "us-east-1", //lintignore:AWSAT003
+	}
+
+	webIdentityCreds, err := config.webIdentityCredentials()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: webIdentityCreds,
+		Region:      aws.String(config.Region),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	creds, err := config.assumeRoleCredentials(sess, config.assumeRole())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	value, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error retrieving credentials: %s", err)
+	}
+
+	if value.AccessKeyID != awsbase.MockStsAssumeRoleAccessKey {
+		t.Errorf("got access key %q, expected %q", value.AccessKeyID, awsbase.MockStsAssumeRoleAccessKey)
+	}
+}
+
+func TestAssumeRoleCredentialsChained(t *testing.T) {
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpoint,
+		awsbase.MockStsAssumeRoleValidEndpoint,
+	})
+	defer ts.Close()
+
+	role := AssumeRole{
+		RoleARN:         awsbase.MockStsAssumeRoleArn,
+		DurationSeconds: 900,
+		SessionName:     awsbase.MockStsAssumeRoleSessionName,
+	}
+
+	config := &Config{
+		AssumeRoleARN:             role.RoleARN,
+		AssumeRoleDurationSeconds: role.DurationSeconds,
+		AssumeRoleSessionName:     role.SessionName,
+		AdditionalAssumeRoles:     []AssumeRole{role},
+		Endpoints:                 map[string]string{STS: ts.URL},
+		Region:                    "us-east-1", //lintignore:AWSAT003
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("MockAccessKey", "MockSecretKey", ""),
+		Region:      aws.String(config.Region),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	roles := append([]*AssumeRole{config.assumeRole()}, &config.AdditionalAssumeRoles[0])
+
+	for _, role := range roles {
+		creds, err := config.assumeRoleCredentials(sess, role)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		sess.Config.Credentials = creds
+	}
+
+	value, err := sess.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error retrieving credentials: %s", err)
+	}
+
+	if value.AccessKeyID != awsbase.MockStsAssumeRoleAccessKey {
+		t.Errorf("got access key %q, expected %q", value.AccessKeyID, awsbase.MockStsAssumeRoleAccessKey)
+	}
+}
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
@@ -130,3 +317,44 @@ var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesRes
     </item>
   </accountAttributeSet>
 </DescribeAccountAttributesResponse>`
+
+func TestServiceConfig(t *testing.T) {
+	c := &Config{
+		Endpoints: map[string]string{
+			EC2: "http://localhost:4566",
+		},
+		ServiceMaxRetries: map[string]int{
+			EC2: 50,
+		},
+	}
+
+	config := c.serviceConfig(EC2)
+
+	if got, want := *config.Endpoint, "http://localhost:4566"; got != want {
+		t.Errorf("expected endpoint %q, got %q", want, got)
+	}
+
+	if config.MaxRetries == nil || *config.MaxRetries != 50 {
+		t.Errorf("expected max retries 50, got %v", config.MaxRetries)
+	}
+
+	config = c.serviceConfig(IAM)
+
+	if got := *config.Endpoint; got != "" {
+		t.Errorf("expected no endpoint, got %q", got)
+	}
+
+	if config.MaxRetries != nil {
+		t.Errorf("expected no max retries override, got %d", *config.MaxRetries)
+	}
+
+	c.EndpointURL = "http://localhost:4567"
+
+	if got, want := *c.serviceConfig(EC2).Endpoint, "http://localhost:4566"; got != want {
+		t.Errorf("expected endpoint %q, got %q", want, got)
+	}
+
+	if got, want := *c.serviceConfig(IAM).Endpoint, "http://localhost:4567"; got != want {
+		t.Errorf("expected endpoint %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,193 @@
+package conns
+
+import (
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	RetryModeAdaptive = "adaptive"
+	RetryModeStandard = "standard"
+)
+
+// RetryMode_Values returns all elements of the retry mode enum
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeAdaptive,
+		RetryModeStandard,
+	}
+}
+
+const (
+	// Rate, in requests per second, that an operation is limited to after its first throttling error.
+	adaptiveRateLimiterInitialRate = 10.0
+	// Lower bound of the rate an operation can be limited to.
+	adaptiveRateLimiterMinRate = 0.5
+	// Rate above which limiting is switched off again for an operation.
+	adaptiveRateLimiterMaxRate = 100.0
+
+	adaptiveRateLimiterBackoffFactor  = 0.7
+	adaptiveRateLimiterRecoveryFactor = 1.05
+)
+
+// AdaptiveRateLimiter is a client-side rate limiter for AWS API operations. Each
+// operation (e.g. EC2 DescribeSecurityGroups) gets a token bucket which is shared
+// by all requests made with the session the limiter is attached to, so concurrent
+// resource operations back off together once the API starts throttling.
+//
+// An operation is not limited until it is first throttled. The rate then
+// decreases with every throttling error and slowly recovers with every
+// successful request.
+type AdaptiveRateLimiter struct {
+	lock  sync.Mutex
+	store map[string]*tokenBucket
+}
+
+// Returns a properly initialized AdaptiveRateLimiter
+func NewAdaptiveRateLimiter() *AdaptiveRateLimiter {
+	return &AdaptiveRateLimiter{
+		store: make(map[string]*tokenBucket),
+	}
+}
+
+// Attach registers the rate limiter with the given request handlers.
+func (l *AdaptiveRateLimiter) Attach(handlers *request.Handlers) {
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRateLimiter.Acquire",
+		Fn: func(r *request.Request) {
+			l.get(r).acquire()
+		},
+	})
+	handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRateLimiter.Throttled",
+		Fn: func(r *request.Request) {
+			if request.IsErrorThrottle(r.Error) {
+				log.Printf("[DEBUG] Limiting request rate for %s", adaptiveRateLimiterKey(r))
+				l.get(r).throttled()
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRateLimiter.Succeeded",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				l.get(r).succeeded()
+			}
+		},
+	})
+}
+
+// Returns the token bucket for the request's operation
+func (l *AdaptiveRateLimiter) get(r *request.Request) *tokenBucket {
+	key := adaptiveRateLimiterKey(r)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	bucket, ok := l.store[key]
+	if !ok {
+		bucket = &tokenBucket{}
+		l.store[key] = bucket
+	}
+	return bucket
+}
+
+func adaptiveRateLimiterKey(r *request.Request) string {
+	return r.ClientInfo.ServiceName + "." + r.Operation.Name
+}
+
+// tokenBucket limits requests to a fill rate once enabled.
+type tokenBucket struct {
+	lock    sync.Mutex
+	enabled bool
+	rate    float64
+	tokens  float64
+	last    time.Time
+	now     func() time.Time
+	sleep   func(time.Duration)
+}
+
+func (b *tokenBucket) acquire() {
+	for {
+		b.lock.Lock()
+
+		if !b.enabled {
+			b.lock.Unlock()
+			return
+		}
+
+		b.refill()
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.lock.Unlock()
+			return
+		}
+
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.lock.Unlock()
+
+		b.sleepFor(wait)
+	}
+}
+
+func (b *tokenBucket) throttled() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.enabled {
+		b.enabled = true
+		b.rate = adaptiveRateLimiterInitialRate
+		b.tokens = 0
+		b.last = b.timeNow()
+		return
+	}
+
+	b.refill()
+	b.rate = math.Max(b.rate*adaptiveRateLimiterBackoffFactor, adaptiveRateLimiterMinRate)
+	b.tokens = math.Min(b.tokens, b.capacity())
+}
+
+func (b *tokenBucket) succeeded() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.enabled {
+		return
+	}
+
+	b.rate *= adaptiveRateLimiterRecoveryFactor
+
+	if b.rate > adaptiveRateLimiterMaxRate {
+		b.enabled = false
+	}
+}
+
+// refill adds the tokens accrued since the last refill. The caller must hold the lock.
+func (b *tokenBucket) refill() {
+	now := b.timeNow()
+	b.tokens = math.Min(b.tokens+now.Sub(b.last).Seconds()*b.rate, b.capacity())
+	b.last = now
+}
+
+func (b *tokenBucket) capacity() float64 {
+	return math.Max(b.rate, 1)
+}
+
+func (b *tokenBucket) timeNow() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+func (b *tokenBucket) sleepFor(d time.Duration) {
+	if b.sleep != nil {
+		b.sleep(d)
+		return
+	}
+	time.Sleep(d)
+}
@@ -0,0 +1,58 @@
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	var slept time.Duration
+
+	bucket := &tokenBucket{
+		now: func() time.Time { return now },
+		sleep: func(d time.Duration) {
+			slept += d
+			now = now.Add(d)
+		},
+	}
+
+	// Requests are not limited until the operation is throttled.
+	for i := 0; i < 100; i++ {
+		bucket.acquire()
+	}
+
+	if slept != 0 {
+		t.Fatalf("expected no wait before throttling, got %s", slept)
+	}
+
+	bucket.throttled()
+
+	if !bucket.enabled {
+		t.Fatal("expected rate limiting to be enabled after throttling")
+	}
+
+	if got, want := bucket.rate, adaptiveRateLimiterInitialRate; got != want {
+		t.Fatalf("expected rate %f, got %f", want, got)
+	}
+
+	bucket.acquire()
+
+	if got, want := slept, 100*time.Millisecond; got != want {
+		t.Fatalf("expected wait %s, got %s", want, got)
+	}
+
+	bucket.throttled()
+
+	if got, want := bucket.rate, adaptiveRateLimiterInitialRate*adaptiveRateLimiterBackoffFactor; got != want {
+		t.Fatalf("expected rate %f, got %f", want, got)
+	}
+
+	for i := 0; i < 1000 && bucket.enabled; i++ {
+		bucket.succeeded()
+	}
+
+	if bucket.enabled {
+		t.Fatal("expected rate limiting to be disabled after recovering")
+	}
+}
@@ -355,7 +355,6 @@ func init() {
 	awsServiceNames["accessanalyzer"] = "AccessAnalyzer"
 	awsServiceNames["acm"] = "ACM"
 	awsServiceNames["acmpca"] = "ACMPCA"
-	awsServiceNames["alexaforbusiness"] = "AlexaForBusiness"
 	awsServiceNames["amplify"] = "Amplify"
 	awsServiceNames["amplifybackend"] = "AmplifyBackend"
 	awsServiceNames["apigateway"] = "APIGateway"
@@ -471,7 +470,6 @@ func init() {
 	awsServiceNames["guardduty"] = "GuardDuty"
 	awsServiceNames["health"] = "Health"
 	awsServiceNames["healthlake"] = "HealthLake"
-	awsServiceNames["honeycode"] = "HoneyCode"
 	awsServiceNames["iam"] = "IAM"
 	awsServiceNames["identitystore"] = "IdentityStore"
 	awsServiceNames["imagebuilder"] = "ImageBuilder"
@@ -515,7 +513,6 @@ func init() {
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
 	awsServiceNames["machinelearning"] = "MachineLearning"
-	awsServiceNames["macie"] = "Macie"
 	awsServiceNames["macie2"] = "Macie2"
 	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
 	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
@@ -534,7 +531,6 @@ func init() {
 	awsServiceNames["mgn"] = "Mgn"
 	awsServiceNames["migrationhub"] = "MigrationHub"
 	awsServiceNames["migrationhubconfig"] = "MigrationHubConfig"
-	awsServiceNames["mobile"] = "Mobile"
 	awsServiceNames["mobileanalytics"] = "MobileAnalytics"
 	awsServiceNames["mq"] = "MQ"
 	awsServiceNames["mturk"] = "MTurk"
@@ -567,10 +563,12 @@ func init() {
 	awsServiceNames["rdsutils"] = "RDSUtils"
 	awsServiceNames["redshift"] = "Redshift"
 	awsServiceNames["redshiftdata"] = "RedshiftData"
+	awsServiceNames["redshiftserverless"] = "RedshiftServerless"
 	awsServiceNames["rekognition"] = "Rekognition"
 	awsServiceNames["resourcegroups"] = "ResourceGroups"
 	awsServiceNames["resourcegroupstaggingapi"] = "ResourceGroupsTaggingAPI"
 	awsServiceNames["robomaker"] = "RoboMaker"
+	awsServiceNames["rolesanywhere"] = "RolesAnywhere"
 	awsServiceNames["route53"] = "Route53"
 	awsServiceNames["route53domains"] = "Route53Domains"
 	awsServiceNames["route53recoverycontrolconfig"] = "Route53RecoveryControlConfig"
@@ -622,6 +620,7 @@ func init() {
 	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
 	awsServiceNames["transfer"] = "Transfer"
 	awsServiceNames["translate"] = "Translate"
+	awsServiceNames["vpclattice"] = "VPCLattice"
 	awsServiceNames["waf"] = "WAF"
 	awsServiceNames["wafregional"] = "WAFRegional"
 	awsServiceNames["wafv2"] = "WAFV2"
@@ -861,7 +861,6 @@ func init() {
 	awsServiceNames["accessanalyzer"] = "AccessAnalyzer"
 	awsServiceNames["acm"] = "ACM"
 	awsServiceNames["acmpca"] = "ACMPCA"
-	awsServiceNames["alexaforbusiness"] = "AlexaForBusiness"
 	awsServiceNames["amplify"] = "Amplify"
 	awsServiceNames["amplifybackend"] = "AmplifyBackend"
 	awsServiceNames["apigateway"] = "APIGateway"
@@ -978,7 +977,6 @@ func init() {
 	awsServiceNames["guardduty"] = "GuardDuty"
 	awsServiceNames["health"] = "Health"
 	awsServiceNames["healthlake"] = "HealthLake"
-	awsServiceNames["honeycode"] = "HoneyCode"
 	awsServiceNames["iam"] = "IAM"
 	awsServiceNames["identitystore"] = "IdentityStore"
 	awsServiceNames["imagebuilder"] = "ImageBuilder"
@@ -1022,7 +1020,6 @@ func init() {
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
 	awsServiceNames["machinelearning"] = "MachineLearning"
-	awsServiceNames["macie"] = "Macie"
 	awsServiceNames["macie2"] = "Macie2"
 	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
 	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
@@ -1041,7 +1038,6 @@ func init() {
 	awsServiceNames["mgn"] = "Mgn"
 	awsServiceNames["migrationhub"] = "MigrationHub"
 	awsServiceNames["migrationhubconfig"] = "MigrationHubConfig"
-	awsServiceNames["mobile"] = "Mobile"
 	awsServiceNames["mobileanalytics"] = "MobileAnalytics"
 	awsServiceNames["mq"] = "MQ"
 	awsServiceNames["mturk"] = "MTurk"
@@ -1074,10 +1070,12 @@ func init() {
 	awsServiceNames["rdsutils"] = "RDSUtils"
 	awsServiceNames["redshift"] = "Redshift"
 	awsServiceNames["redshiftdata"] = "RedshiftData"
+	awsServiceNames["redshiftserverless"] = "RedshiftServerless"
 	awsServiceNames["rekognition"] = "Rekognition"
 	awsServiceNames["resourcegroups"] = "ResourceGroups"
 	awsServiceNames["resourcegroupstaggingapi"] = "ResourceGroupsTaggingAPI"
 	awsServiceNames["robomaker"] = "RoboMaker"
+	awsServiceNames["rolesanywhere"] = "RolesAnywhere"
 	awsServiceNames["route53"] = "Route53"
 	awsServiceNames["route53domains"] = "Route53Domains"
 	awsServiceNames["route53recoverycontrolconfig"] = "Route53RecoveryControlConfig"
@@ -1129,6 +1127,7 @@ func init() {
 	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
 	awsServiceNames["transfer"] = "Transfer"
 	awsServiceNames["translate"] = "Translate"
+	awsServiceNames["vpclattice"] = "VPCLattice"
 	awsServiceNames["waf"] = "WAF"
 	awsServiceNames["wafregional"] = "WAFRegional"
 	awsServiceNames["wafv2"] = "WAFV2"
@@ -101,7 +101,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
@@ -112,6 +111,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -122,8 +122,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
@@ -154,6 +156,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
@@ -195,6 +198,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -227,6 +232,21 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      conns.RetryModeStandard,
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
+
+			"service_max_retries": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: descriptions["service_max_retries"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -268,6 +288,13 @@ func Provider() *schema.Provider {
 
 			"endpoints": endpointsSchema(),
 
+			"endpoint_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_ENDPOINT_URL", ""),
+				Description: descriptions["endpoint_url"],
+			},
+
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -341,6 +368,19 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"elasticache_validate_parameters": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["elasticache_validate_parameters"],
+			},
+
+			"elasticache_parameter_group_default_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["elasticache_parameter_group_default_description"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -514,16 +554,20 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system":   efs.DataSourceFileSystem(),
 			"aws_efs_mount_target":  efs.DataSourceMountTarget(),
 
-			"aws_eks_addon":        eks.DataSourceAddon(),
-			"aws_eks_cluster":      eks.DataSourceCluster(),
-			"aws_eks_clusters":     eks.DataSourceClusters(),
-			"aws_eks_cluster_auth": eks.DataSourceClusterAuth(),
-			"aws_eks_node_group":   eks.DataSourceNodeGroup(),
-			"aws_eks_node_groups":  eks.DataSourceNodeGroups(),
-
-			"aws_elasticache_cluster":           elasticache.DataSourceCluster(),
-			"aws_elasticache_replication_group": elasticache.DataSourceReplicationGroup(),
-			"aws_elasticache_user":              elasticache.DataSourceUser(),
+			"aws_eks_addon":         eks.DataSourceAddon(),
+			"aws_eks_addon_version": eks.DataSourceAddonVersion(),
+			"aws_eks_cluster":       eks.DataSourceCluster(),
+			"aws_eks_clusters":      eks.DataSourceClusters(),
+			"aws_eks_cluster_auth":  eks.DataSourceClusterAuth(),
+			"aws_eks_node_group":    eks.DataSourceNodeGroup(),
+			"aws_eks_node_groups":   eks.DataSourceNodeGroups(),
+
+			"aws_elasticache_cluster":                           elasticache.DataSourceCluster(),
+			"aws_elasticache_default_parameter":                 elasticache.DataSourceDefaultParameter(),
+			"aws_elasticache_parameter_group_family_parameters": elasticache.DataSourceParameterGroupFamilyParameters(),
+			"aws_elasticache_replication_group":                 elasticache.DataSourceReplicationGroup(),
+			"aws_elasticache_reserved_cache_node_offering":      elasticache.DataSourceReservedCacheNodeOffering(),
+			"aws_elasticache_user":                              elasticache.DataSourceUser(),
 
 			"aws_elastic_beanstalk_application":    elasticbeanstalk.DataSourceApplication(),
 			"aws_elastic_beanstalk_hosted_zone":    elasticbeanstalk.DataSourceHostedZone(),
@@ -664,6 +708,7 @@ func Provider() *schema.Provider {
 			"aws_rds_certificate":           rds.DataSourceCertificate(),
 			"aws_rds_cluster":               rds.DataSourceCluster(),
 			"aws_rds_engine_version":        rds.DataSourceEngineVersion(),
+			"aws_rds_engine_versions":       rds.DataSourceEngineVersions(),
 			"aws_rds_orderable_db_instance": rds.DataSourceOrderableInstance(),
 
 			"aws_redshift_cluster":           redshift.DataSourceCluster(),
@@ -830,7 +875,10 @@ func Provider() *schema.Provider {
 			"aws_apprunner_auto_scaling_configuration_version": apprunner.ResourceAutoScalingConfigurationVersion(),
 			"aws_apprunner_connection":                         apprunner.ResourceConnection(),
 			"aws_apprunner_custom_domain_association":          apprunner.ResourceCustomDomainAssociation(),
+			"aws_apprunner_observability_configuration":        apprunner.ResourceObservabilityConfiguration(),
 			"aws_apprunner_service":                            apprunner.ResourceService(),
+			"aws_apprunner_vpc_connector":                      apprunner.ResourceVPCConnector(),
+			"aws_apprunner_vpc_ingress_connection":             apprunner.ResourceVPCIngressConnection(),
 
 			"aws_appstream_directory_config":        appstream.ResourceDirectoryConfig(),
 			"aws_appstream_fleet":                   appstream.ResourceFleet(),
@@ -1075,10 +1123,12 @@ func Provider() *schema.Provider {
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
 
+			"aws_dynamodb_contributor_insights":          dynamodb.ResourceContributorInsights(),
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
 			"aws_ami":                                             ec2.ResourceAMI(),
@@ -1095,11 +1145,13 @@ func Provider() *schema.Provider {
 			"aws_ebs_default_kms_key":                             ec2.ResourceEBSDefaultKMSKey(),
 			"aws_ebs_encryption_by_default":                       ec2.ResourceEBSEncryptionByDefault(),
 			"aws_ebs_snapshot":                                    ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_block_public_access":                ec2.ResourceEBSSnapshotBlockPublicAccess(),
 			"aws_ebs_snapshot_copy":                               ec2.ResourceEBSSnapshotCopy(),
 			"aws_ebs_snapshot_import":                             ec2.ResourceEBSSnapshotImport(),
 			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
 			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
 			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
+			"aws_ec2_capacity_reservation_fleet":                  ec2.ResourceCapacityReservationFleet(),
 			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
@@ -1107,10 +1159,14 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
 			"aws_ec2_host":                                        ec2.ResourceHost(),
+			"aws_ec2_image_block_public_access":                   ec2.ResourceImageBlockPublicAccess(),
+			"aws_ec2_instance_connect_endpoint":                   ec2.ResourceInstanceConnectEndpoint(),
 			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                   ec2.ResourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                       ec2.ResourceNetworkInsightsPath(),
 			"aws_ec2_subnet_cidr_reservation":                     ec2.ResourceSubnetCIDRReservation(),
 			"aws_ec2_tag":                                         ec2.ResourceTag(),
 			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
@@ -1118,8 +1174,11 @@ func Provider() *schema.Provider {
 			"aws_ec2_traffic_mirror_session":                      ec2.ResourceTrafficMirrorSession(),
 			"aws_ec2_traffic_mirror_target":                       ec2.ResourceTrafficMirrorTarget(),
 			"aws_ec2_transit_gateway":                             ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                     ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                ec2.ResourceTransitGatewayConnectPeer(),
 			"aws_ec2_transit_gateway_peering_attachment":          ec2.ResourceTransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment_accepter": ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_policy_table":                ec2.ResourceTransitGatewayPolicyTable(),
 			"aws_ec2_transit_gateway_prefix_list_reference":       ec2.ResourceTransitGatewayPrefixListReference(),
 			"aws_ec2_transit_gateway_route":                       ec2.ResourceTransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                 ec2.ResourceTransitGatewayRouteTable(),
@@ -1176,6 +1235,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                  ec2.ResourceVPCSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                 ec2.ResourceVPCSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
@@ -1208,17 +1269,22 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system_policy": efs.ResourceFileSystemPolicy(),
 			"aws_efs_mount_target":       efs.ResourceMountTarget(),
 
-			"aws_eks_addon":                    eks.ResourceAddon(),
-			"aws_eks_cluster":                  eks.ResourceCluster(),
-			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
-			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
-			"aws_eks_node_group":               eks.ResourceNodeGroup(),
+			"aws_eks_access_entry":              eks.ResourceAccessEntry(),
+			"aws_eks_access_policy_association": eks.ResourceAccessPolicyAssociation(),
+			"aws_eks_addon":                     eks.ResourceAddon(),
+			"aws_eks_cluster":                   eks.ResourceCluster(),
+			"aws_eks_fargate_profile":           eks.ResourceFargateProfile(),
+			"aws_eks_identity_provider_config":  eks.ResourceIdentityProviderConfig(),
+			"aws_eks_node_group":                eks.ResourceNodeGroup(),
+			"aws_eks_pod_identity_association":  eks.ResourcePodIdentityAssociation(),
 
 			"aws_elasticache_cluster":                  elasticache.ResourceCluster(),
 			"aws_elasticache_global_replication_group": elasticache.ResourceGlobalReplicationGroup(),
 			"aws_elasticache_parameter_group":          elasticache.ResourceParameterGroup(),
 			"aws_elasticache_replication_group":        elasticache.ResourceReplicationGroup(),
+			"aws_elasticache_reserved_cache_node":      elasticache.ResourceReservedCacheNode(),
 			"aws_elasticache_security_group":           elasticache.ResourceSecurityGroup(),
+			"aws_elasticache_serverless_cache":         elasticache.ResourceServerlessCache(),
 			"aws_elasticache_subnet_group":             elasticache.ResourceSubnetGroup(),
 			"aws_elasticache_user":                     elasticache.ResourceUser(),
 			"aws_elasticache_user_group":               elasticache.ResourceUserGroup(),
@@ -1323,29 +1389,36 @@ func Provider() *schema.Provider {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
-			"aws_iam_access_key":              iam.ResourceAccessKey(),
-			"aws_iam_account_alias":           iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy": iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                   iam.ResourceGroup(),
-			"aws_iam_group_membership":        iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":            iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment": iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":        iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider": iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":       iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                    iam.ResourceRole(),
-			"aws_iam_role_policy":             iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":  iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":           iam.ResourceSamlProvider(),
-			"aws_iam_server_certificate":      iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":     iam.ResourceServiceLinkedRole(),
-			"aws_iam_user":                    iam.ResourceUser(),
-			"aws_iam_user_group_membership":   iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":      iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":             iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":  iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":            iam.ResourceUserSSHKey(),
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_saml_provider":                      iam.ResourceSamlProvider(),
+			"aws_iam_security_token_service_preferences": iam.ResourceSecurityTokenServicePreferences(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_signing_certificate":                iam.ResourceSigningCertificate(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                 iam.ResourceVirtualMFADevice(),
+
+			"aws_identitystore_group":            identitystore.ResourceGroup(),
+			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_user":             identitystore.ResourceUser(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
@@ -1403,11 +1476,13 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_url":                   lambda.ResourceFunctionURL(),
 			"aws_lambda_invocation":                     lambda.ResourceInvocation(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
 			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(),
+			"aws_lambda_runtime_management_config":      lambda.ResourceRuntimeManagementConfig(),
 
 			"aws_lex_bot":       lexmodels.ResourceBot(),
 			"aws_lex_bot_alias": lexmodels.ResourceBotAlias(),
@@ -1424,9 +1499,6 @@ func Provider() *schema.Provider {
 			"aws_lightsail_static_ip":             lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":  lightsail.ResourceStaticIPAttachment(),
 
-			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
-			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
-
 			"aws_macie2_account":                    macie2.ResourceAccount(),
 			"aws_macie2_classification_job":         macie2.ResourceClassificationJob(),
 			"aws_macie2_custom_data_identifier":     macie2.ResourceCustomDataIdentifier(),
@@ -1469,6 +1541,14 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
 
+			"aws_networkmanager_attachment_accepter":            networkmanager.ResourceAttachmentAccepter(),
+			"aws_networkmanager_connect_attachment":             networkmanager.ResourceConnectAttachment(),
+			"aws_networkmanager_core_network":                   networkmanager.ResourceCoreNetwork(),
+			"aws_networkmanager_core_network_policy_attachment": networkmanager.ResourceCoreNetworkPolicyAttachment(),
+			"aws_networkmanager_global_network":                 networkmanager.ResourceGlobalNetwork(),
+			"aws_networkmanager_site_to_site_vpn_attachment":    networkmanager.ResourceSiteToSiteVPNAttachment(),
+			"aws_networkmanager_vpc_attachment":                 networkmanager.ResourceVPCAttachment(),
+
 			"aws_opsworks_application":      opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":     opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ganglia_layer":    opsworks.ResourceGangliaLayer(),
@@ -1535,19 +1615,31 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(),
+			"aws_rds_export_task":               rds.ResourceExportTask(),
 			"aws_rds_global_cluster":            rds.ResourceGlobalCluster(),
 
-			"aws_redshift_cluster":                       redshift.ResourceCluster(),
-			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
-			"aws_redshift_parameter_group":               redshift.ResourceParameterGroup(),
-			"aws_redshift_scheduled_action":              redshift.ResourceScheduledAction(),
-			"aws_redshift_security_group":                redshift.ResourceSecurityGroup(),
-			"aws_redshift_snapshot_copy_grant":           redshift.ResourceSnapshotCopyGrant(),
-			"aws_redshift_snapshot_schedule":             redshift.ResourceSnapshotSchedule(),
-			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
-			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
-
-			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
+			"aws_redshift_cluster":                         redshift.ResourceCluster(),
+			"aws_redshift_data_share_authorization":        redshift.ResourceDataShareAuthorization(),
+			"aws_redshift_data_share_consumer_association": redshift.ResourceDataShareConsumerAssociation(),
+			"aws_redshift_event_subscription":              redshift.ResourceEventSubscription(),
+			"aws_redshift_parameter_group":                 redshift.ResourceParameterGroup(),
+			"aws_redshift_scheduled_action":                redshift.ResourceScheduledAction(),
+			"aws_redshift_security_group":                  redshift.ResourceSecurityGroup(),
+			"aws_redshift_snapshot_copy_grant":             redshift.ResourceSnapshotCopyGrant(),
+			"aws_redshift_snapshot_schedule":               redshift.ResourceSnapshotSchedule(),
+			"aws_redshift_snapshot_schedule_association":   redshift.ResourceSnapshotScheduleAssociation(),
+			"aws_redshift_subnet_group":                    redshift.ResourceSubnetGroup(),
+
+			"aws_redshiftserverless_namespace":   redshiftserverless.ResourceNamespace(),
+			"aws_redshiftserverless_usage_limit": redshiftserverless.ResourceUsageLimit(),
+			"aws_redshiftserverless_workgroup":   redshiftserverless.ResourceWorkgroup(),
+
+			"aws_resourcegroups_group":    resourcegroups.ResourceGroup(),
+			"aws_resourcegroups_resource": resourcegroups.ResourceResource(),
+
+			"aws_rolesanywhere_crl":          rolesanywhere.ResourceCRL(),
+			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
+			"aws_rolesanywhere_trust_anchor": rolesanywhere.ResourceTrustAnchor(),
 
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
@@ -1581,18 +1673,24 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
 
-			"aws_s3_bucket":                                   s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
-			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_bucket_replication_configuration":         s3.ResourceBucketReplicationConfiguration(),
-			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCorsConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
@@ -1724,10 +1822,12 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
-			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
+			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                     ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":       ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_permissions_boundary_attachment":    ssoadmin.ResourcePermissionsBoundaryAttachment(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -1752,6 +1852,14 @@ func Provider() *schema.Provider {
 			"aws_transfer_ssh_key": transfer.ResourceSSHKey(),
 			"aws_transfer_user":    transfer.ResourceUser(),
 
+			"aws_vpclattice_auth_policy":                         vpclattice.ResourceAuthPolicy(),
+			"aws_vpclattice_listener":                            vpclattice.ResourceListener(),
+			"aws_vpclattice_service":                             vpclattice.ResourceService(),
+			"aws_vpclattice_service_network":                     vpclattice.ResourceServiceNetwork(),
+			"aws_vpclattice_service_network_service_association": vpclattice.ResourceServiceNetworkServiceAssociation(),
+			"aws_vpclattice_service_network_vpc_association":     vpclattice.ResourceServiceNetworkVPCAssociation(),
+			"aws_vpclattice_target_group":                        vpclattice.ResourceTargetGroup(),
+
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
 			"aws_waf_ipset":                   waf.ResourceIPSet(),
@@ -1838,11 +1946,21 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.\n" +
+			"In adaptive mode, API operations that are throttled are rate limited client-side\n" +
+			"across all concurrent resource operations.",
+
+		"service_max_retries": "Overrides max_retries for individual services, keyed by the service's\n" +
+			"endpoints argument name, e.g. `ec2`.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"endpoint_url": "Use this to override the default endpoint URL of all services\n" +
+			"that do not have an endpoint configured in the endpoints block.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
@@ -1865,6 +1983,13 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"elasticache_validate_parameters": "Set this to true to validate ElastiCache parameter group parameter\n" +
+			"names and values against the engine defaults of the parameter group family during plan.\n" +
+			"Requires elasticache:DescribeEngineDefaultParameters permissions.",
+
+		"elasticache_parameter_group_default_description": "The description assigned to ElastiCache parameter groups\n" +
+			"created without one. Defaults to \"Managed by Terraform\".",
 	}
 }
 
@@ -1878,7 +2003,9 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		CredsFilename:           d.Get("shared_credentials_file").(string),
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
+		EndpointURL:             d.Get("endpoint_url").(string),
 		MaxRetries:              d.Get("max_retries").(int),
+		RetryMode:               d.Get("retry_mode").(string),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
@@ -1889,70 +2016,82 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
 		TerraformVersion:        terraformVersion,
+
+		ElastiCacheDefaultDescription: d.Get("elasticache_parameter_group_default_description").(string),
+		ElastiCacheValidateParameters: d.Get("elasticache_validate_parameters").(bool),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
+		role := expandProviderAssumeRole(l[0].(map[string]interface{}))
 
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
+		config.AssumeRoleARN = role.RoleARN
+		config.AssumeRoleDurationSeconds = role.DurationSeconds
+		config.AssumeRoleExternalID = role.ExternalID
+		config.AssumeRolePolicy = role.Policy
+		config.AssumeRolePolicyARNs = role.PolicyARNs
+		config.AssumeRoleSessionName = role.SessionName
+		config.AssumeRoleTags = role.Tags
+		config.AssumeRoleTransitiveTagKeys = role.TransitiveTagKeys
 
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
+		for _, tfMapRaw := range l[1:] {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
 
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
+			if !ok {
+				continue
+			}
 
-				if !ok {
-					continue
-				}
+			role := expandProviderAssumeRole(tfMap)
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
+			if role.RoleARN == "" {
+				return nil, fmt.Errorf("role_arn must be set in chained assume_role configuration blocks")
 			}
+
+			config.AdditionalAssumeRoles = append(config.AdditionalAssumeRoles, role)
+
+			log.Printf("[INFO] chained assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", role.RoleARN, role.SessionName, role.ExternalID)
+		}
+	}
+
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
 		}
 
 		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
+			config.AssumeRoleWithWebIdentityARN = v
 		}
 
 		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
+			config.AssumeRoleWithWebIdentitySessionName = v
 		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
-
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
-
-				if !ok {
-					continue
-				}
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
 
-				config.AssumeRoleTags[k] = v
-			}
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
 		}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
+	if v, ok := d.GetOk("service_max_retries"); ok && len(v.(map[string]interface{})) > 0 {
+		config.ServiceMaxRetries = make(map[string]int)
 
-				if !ok {
-					continue
-				}
+		for hclKey, v := range v.(map[string]interface{}) {
+			serviceKey, err := conns.ServiceForHCLKey(hclKey)
 
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign max retries (%s): %w", hclKey, err)
 			}
-		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+			config.ServiceMaxRetries[serviceKey] = v.(int)
+		}
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1992,7 +2131,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -2057,6 +2195,53 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 64),
+						validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
+					),
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "The OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ValidateFunc:  validation.StringLenBetween(4, 20000),
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "File containing the OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2069,6 +2254,17 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	// Endpoints of services retired by AWS are still accepted so that existing
+	// configurations keep working, but they are no longer used.
+	for _, serviceKey := range []string{"alexaforbusiness", "honeycode", "macie", "mobile"} {
+		endpointsAttributes[serviceKey] = &schema.Schema{
+			Type:       schema.TypeString,
+			Optional:   true,
+			Default:    "",
+			Deprecated: "AWS has retired this service. The endpoint is ignored and the argument will be removed in a future major version.",
+		}
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -2078,6 +2274,70 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderAssumeRole(m map[string]interface{}) conns.AssumeRole {
+	role := conns.AssumeRole{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		role.DurationSeconds = v
+	}
+
+	if v, ok := m["external_id"].(string); ok && v != "" {
+		role.ExternalID = v
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		role.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			role.PolicyARNs = append(role.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		role.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		role.SessionName = v
+	}
+
+	if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+		role.Tags = make(map[string]string)
+
+		for k, vRaw := range tagMapRaw {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			role.Tags[k] = v
+		}
+	}
+
+	if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+		for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+			transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			role.TransitiveTagKeys = append(role.TransitiveTagKeys, transitiveTagKey)
+		}
+	}
+
+	return role
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -27,7 +27,11 @@ func ResourceRestAPI() *schema.Resource {
 		Update: resourceRestAPIUpdate,
 		Delete: resourceRestAPIDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("put_rest_api_mode", apigateway.PutModeOverwrite)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,8 +73,10 @@ func ResourceRestAPI() *schema.Resource {
 			},
 
 			"body": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
 			},
 
 			"disable_execute_api_endpoint": {
@@ -85,6 +91,13 @@ func ResourceRestAPI() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"put_rest_api_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      apigateway.PutModeOverwrite,
+				ValidateFunc: validation.StringInSlice(apigateway.PutMode_Values(), false),
+			},
+
 			"minimum_compression_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -216,7 +229,7 @@ func resourceRestAPICreate(d *schema.ResourceData, meta interface{}) error {
 
 		input := &apigateway.PutRestApiInput{
 			RestApiId: gateway.Id,
-			Mode:      aws.String(apigateway.PutModeOverwrite),
+			Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 			Body:      []byte(body.(string)),
 		}
 
@@ -597,7 +610,7 @@ func resourceRestAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			input := &apigateway.PutRestApiInput{
 				RestApiId: aws.String(d.Id()),
-				Mode:      aws.String(apigateway.PutModeOverwrite),
+				Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 				Body:      []byte(body.(string)),
 			}
 
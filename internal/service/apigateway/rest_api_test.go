@@ -1043,6 +1043,50 @@ func TestAccAPIGatewayRestAPI_Name_overrideBody(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_putRestAPIMode(t *testing.T) {
+	var conf apigateway.RestApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIPutRestAPIModeConfig(rName, apigateway.PutModeMerge, "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeMerge),
+					testAccCheckRestAPIRoutes(&conf, []string{"/", "/test"}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+			},
+			{
+				Config: testAccRestAPIPutRestAPIModeConfig(rName, apigateway.PutModeMerge, "/test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					testAccCheckRestAPIRoutes(&conf, []string{"/", "/test", "/test2"}),
+				),
+			},
+			{
+				Config: testAccRestAPIPutRestAPIModeConfig(rName, apigateway.PutModeOverwrite, "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", apigateway.PutModeOverwrite),
+					testAccCheckRestAPIRoutes(&conf, []string{"/", "/test"}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_parameters(t *testing.T) {
 	var conf apigateway.RestApi
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2289,6 +2333,45 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, parameterKey1, parameterValue1)
 }
 
+func testAccRestAPIPutRestAPIModeConfig(rName, mode, path string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name              = %[1]q
+  put_rest_api_mode = %[2]q
+
+  body = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "test"
+      version = "2017-04-20T04:08:08Z"
+    }
+    schemes = ["https"]
+    paths = {
+      %[3]q = {
+        get = {
+          responses = {
+            "200" = {
+              description = "OK"
+            }
+          }
+          x-amazon-apigateway-integration = {
+            httpMethod = "GET"
+            type       = "HTTP"
+            responses = {
+              default = {
+                statusCode = 200
+              }
+            }
+            uri = "https://api.example.com/"
+          }
+        }
+      }
+    }
+  })
+}
+`, rName, mode, path)
+}
+
 func testAccRestAPIPolicyOverrideBodyConfig(rName string, bodyPath string, policyEffect string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
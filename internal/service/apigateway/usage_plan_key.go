@@ -4,12 +4,20 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Attaching many keys to the same usage plan concurrently is throttled
+	// and can conflict with other in-flight modifications of the plan.
+	usagePlanKeyModifyTimeout = 5 * time.Minute
 )
 
 func ResourceUsagePlanKey() *schema.Resource {
@@ -74,13 +82,15 @@ func resourceUsagePlanKeyCreate(d *schema.ResourceData, meta interface{}) error
 		UsagePlanId: aws.String(d.Get("usage_plan_id").(string)),
 	}
 
-	up, err := conn.CreateUsagePlanKey(params)
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(usagePlanKeyModifyTimeout, func() (interface{}, error) {
+		return conn.CreateUsagePlanKey(params)
+	}, apigateway.ErrCodeTooManyRequestsException, apigateway.ErrCodeConflictException)
 
 	if err != nil {
 		return fmt.Errorf("error creating API Gateway Usage Plan Key: %w", err)
 	}
 
-	d.SetId(aws.StringValue(up.Id))
+	d.SetId(aws.StringValue(outputRaw.(*apigateway.UsagePlanKey).Id))
 
 	return resourceUsagePlanKeyRead(d, meta)
 }
@@ -113,10 +123,13 @@ func resourceUsagePlanKeyDelete(d *schema.ResourceData, meta interface{}) error
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 
 	log.Printf("[DEBUG] Deleting API Gateway Usage Plan Key: %s", d.Id())
-	_, err := conn.DeleteUsagePlanKey(&apigateway.DeleteUsagePlanKeyInput{
-		UsagePlanId: aws.String(d.Get("usage_plan_id").(string)),
-		KeyId:       aws.String(d.Get("key_id").(string)),
-	})
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(usagePlanKeyModifyTimeout, func() (interface{}, error) {
+		return conn.DeleteUsagePlanKey(&apigateway.DeleteUsagePlanKeyInput{
+			UsagePlanId: aws.String(d.Get("usage_plan_id").(string)),
+			KeyId:       aws.String(d.Get("key_id").(string)),
+		})
+	}, apigateway.ErrCodeTooManyRequestsException, apigateway.ErrCodeConflictException)
+
 	if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 		return nil
 	}
@@ -90,3 +90,63 @@ func StatusService(ctx context.Context, conn *apprunner.AppRunner, serviceArn st
 		return output.Service, aws.StringValue(output.Service.Status), nil
 	}
 }
+
+func StatusObservabilityConfiguration(ctx context.Context, conn *apprunner.AppRunner, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &apprunner.DescribeObservabilityConfigurationInput{
+			ObservabilityConfigurationArn: aws.String(arn),
+		}
+
+		output, err := conn.DescribeObservabilityConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ObservabilityConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output.ObservabilityConfiguration, aws.StringValue(output.ObservabilityConfiguration.Status), nil
+	}
+}
+
+func StatusVPCConnector(ctx context.Context, conn *apprunner.AppRunner, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &apprunner.DescribeVpcConnectorInput{
+			VpcConnectorArn: aws.String(arn),
+		}
+
+		output, err := conn.DescribeVpcConnectorWithContext(ctx, input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.VpcConnector == nil {
+			return nil, "", nil
+		}
+
+		return output.VpcConnector, aws.StringValue(output.VpcConnector.Status), nil
+	}
+}
+
+func StatusVPCIngressConnection(ctx context.Context, conn *apprunner.AppRunner, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &apprunner.DescribeVpcIngressConnectionInput{
+			VpcIngressConnectionArn: aws.String(arn),
+		}
+
+		output, err := conn.DescribeVpcIngressConnectionWithContext(ctx, input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.VpcIngressConnection == nil {
+			return nil, "", nil
+		}
+
+		return output.VpcIngressConnection, aws.StringValue(output.VpcIngressConnection.Status), nil
+	}
+}
@@ -29,9 +29,27 @@ func init() {
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
+	resource.AddTestSweepers("aws_apprunner_observability_configuration", &resource.Sweeper{
+		Name:         "aws_apprunner_observability_configuration",
+		F:            sweepObservabilityConfigurations,
+		Dependencies: []string{"aws_apprunner_service"},
+	})
+
 	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
-		Name: "aws_apprunner_service",
-		F:    sweepServices,
+		Name:         "aws_apprunner_service",
+		F:            sweepServices,
+		Dependencies: []string{"aws_apprunner_vpc_ingress_connection"},
+	})
+
+	resource.AddTestSweepers("aws_apprunner_vpc_connector", &resource.Sweeper{
+		Name:         "aws_apprunner_vpc_connector",
+		F:            sweepVPCConnectors,
+		Dependencies: []string{"aws_apprunner_service"},
+	})
+
+	resource.AddTestSweepers("aws_apprunner_vpc_ingress_connection", &resource.Sweeper{
+		Name: "aws_apprunner_vpc_ingress_connection",
+		F:    sweepVPCIngressConnections,
 	})
 }
 
@@ -219,3 +237,177 @@ func sweepServices(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepObservabilityConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AppRunnerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &apprunner.ListObservabilityConfigurationsInput{}
+
+	err = conn.ListObservabilityConfigurationsPagesWithContext(ctx, input, func(page *apprunner.ListObservabilityConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.ObservabilityConfigurationSummaryList {
+			if summary == nil {
+				continue
+			}
+
+			arn := aws.StringValue(summary.ObservabilityConfigurationArn)
+
+			log.Printf("[INFO] Deleting App Runner Observability Configuration (%s)", arn)
+			r := ResourceObservabilityConfiguration()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Runner Observability Configurations sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner Observability Configurations: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner Observability Configurations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Runner Observability Configurations sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepVPCConnectors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AppRunnerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &apprunner.ListVpcConnectorsInput{}
+
+	err = conn.ListVpcConnectorsPagesWithContext(ctx, input, func(page *apprunner.ListVpcConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.VpcConnectors {
+			if summary == nil {
+				continue
+			}
+
+			arn := aws.StringValue(summary.VpcConnectorArn)
+
+			log.Printf("[INFO] Deleting App Runner VPC Connector (%s)", arn)
+			r := ResourceVPCConnector()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Runner VPC Connectors sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner VPC Connectors: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner VPC Connectors for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Runner VPC Connectors sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepVPCIngressConnections(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AppRunnerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &apprunner.ListVpcIngressConnectionsInput{}
+
+	err = conn.ListVpcIngressConnectionsPagesWithContext(ctx, input, func(page *apprunner.ListVpcIngressConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.VpcIngressConnectionSummaryList {
+			if summary == nil {
+				continue
+			}
+
+			arn := aws.StringValue(summary.VpcIngressConnectionArn)
+
+			log.Printf("[INFO] Deleting App Runner VPC Ingress Connection (%s)", arn)
+			r := ResourceVPCIngressConnection()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Runner VPC Ingress Connections sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner VPC Ingress Connections: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner VPC Ingress Connections for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Runner VPC Ingress Connections sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
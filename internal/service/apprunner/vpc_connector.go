@@ -0,0 +1,209 @@
+package apprunner
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCConnector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCConnectorCreate,
+		ReadWithoutTimeout:   resourceVPCConnectorRead,
+		UpdateWithoutTimeout: resourceVPCConnectorUpdate,
+		DeleteWithoutTimeout: resourceVPCConnectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnets": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_connector_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 40),
+			},
+			"vpc_connector_revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("vpc_connector_name").(string)
+
+	input := &apprunner.CreateVpcConnectorInput{
+		SecurityGroups:   flex.ExpandStringSet(d.Get("security_groups").(*schema.Set)),
+		Subnets:          flex.ExpandStringSet(d.Get("subnets").(*schema.Set)),
+		VpcConnectorName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateVpcConnectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating App Runner VPC Connector (%s): %w", name, err))
+	}
+
+	if output == nil || output.VpcConnector == nil {
+		return diag.FromErr(fmt.Errorf("error creating App Runner VPC Connector (%s): empty output", name))
+	}
+
+	d.SetId(aws.StringValue(output.VpcConnector.VpcConnectorArn))
+
+	if err := WaitVPCConnectorActive(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for App Runner VPC Connector (%s) creation: %w", d.Id(), err))
+	}
+
+	return resourceVPCConnectorRead(ctx, d, meta)
+}
+
+func resourceVPCConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &apprunner.DescribeVpcConnectorInput{
+		VpcConnectorArn: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeVpcConnectorWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] App Runner VPC Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner VPC Connector (%s): %w", d.Id(), err))
+	}
+
+	if output == nil || output.VpcConnector == nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner VPC Connector (%s): empty output", d.Id()))
+	}
+
+	if aws.StringValue(output.VpcConnector.Status) == apprunner.VpcConnectorStatusInactive {
+		if d.IsNewResource() {
+			return diag.FromErr(fmt.Errorf("error reading App Runner VPC Connector (%s): %s after creation", d.Id(), aws.StringValue(output.VpcConnector.Status)))
+		}
+		log.Printf("[WARN] App Runner VPC Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	vpcConnector := output.VpcConnector
+	arn := aws.StringValue(vpcConnector.VpcConnectorArn)
+
+	d.Set("arn", arn)
+	d.Set("security_groups", aws.StringValueSlice(vpcConnector.SecurityGroups))
+	d.Set("status", vpcConnector.Status)
+	d.Set("subnets", aws.StringValueSlice(vpcConnector.Subnets))
+	d.Set("vpc_connector_name", vpcConnector.VpcConnectorName)
+	d.Set("vpc_connector_revision", vpcConnector.VpcConnectorRevision)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for App Runner VPC Connector (%s): %s", arn, err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceVPCConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating App Runner VPC Connector (%s) tags: %s", d.Get("arn").(string), err))
+		}
+	}
+
+	return resourceVPCConnectorRead(ctx, d, meta)
+}
+
+func resourceVPCConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	input := &apprunner.DeleteVpcConnectorInput{
+		VpcConnectorArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteVpcConnectorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting App Runner VPC Connector (%s): %w", d.Id(), err))
+	}
+
+	if err := WaitVPCConnectorInactive(ctx, conn, d.Id()); err != nil {
+		if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error waiting for App Runner VPC Connector (%s) deletion: %w", d.Id(), err))
+	}
+
+	return nil
+}
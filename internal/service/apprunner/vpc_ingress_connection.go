@@ -0,0 +1,276 @@
+package apprunner
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCIngressConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCIngressConnectionCreate,
+		ReadWithoutTimeout:   resourceVPCIngressConnectionRead,
+		UpdateWithoutTimeout: resourceVPCIngressConnectionUpdate,
+		DeleteWithoutTimeout: resourceVPCIngressConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingress_vpc_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 40),
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCIngressConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+
+	input := &apprunner.CreateVpcIngressConnectionInput{
+		IngressVpcConfiguration:  expandAppRunnerIngressVPCConfiguration(d.Get("ingress_vpc_configuration").([]interface{})),
+		ServiceArn:               aws.String(d.Get("service_arn").(string)),
+		VpcIngressConnectionName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateVpcIngressConnectionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating App Runner VPC Ingress Connection (%s): %w", name, err))
+	}
+
+	if output == nil || output.VpcIngressConnection == nil {
+		return diag.FromErr(fmt.Errorf("error creating App Runner VPC Ingress Connection (%s): empty output", name))
+	}
+
+	d.SetId(aws.StringValue(output.VpcIngressConnection.VpcIngressConnectionArn))
+
+	if err := WaitVPCIngressConnectionAvailable(ctx, conn, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for App Runner VPC Ingress Connection (%s) creation: %w", d.Id(), err))
+	}
+
+	return resourceVPCIngressConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCIngressConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &apprunner.DescribeVpcIngressConnectionInput{
+		VpcIngressConnectionArn: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeVpcIngressConnectionWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] App Runner VPC Ingress Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner VPC Ingress Connection (%s): %w", d.Id(), err))
+	}
+
+	if output == nil || output.VpcIngressConnection == nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner VPC Ingress Connection (%s): empty output", d.Id()))
+	}
+
+	if aws.StringValue(output.VpcIngressConnection.Status) == apprunner.VpcIngressConnectionStatusDeleted {
+		if d.IsNewResource() {
+			return diag.FromErr(fmt.Errorf("error reading App Runner VPC Ingress Connection (%s): %s after creation", d.Id(), aws.StringValue(output.VpcIngressConnection.Status)))
+		}
+		log.Printf("[WARN] App Runner VPC Ingress Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	connection := output.VpcIngressConnection
+	arn := aws.StringValue(connection.VpcIngressConnectionArn)
+
+	d.Set("arn", arn)
+	d.Set("domain_name", connection.DomainName)
+	d.Set("name", connection.VpcIngressConnectionName)
+	d.Set("service_arn", connection.ServiceArn)
+	d.Set("status", connection.Status)
+
+	if err := d.Set("ingress_vpc_configuration", flattenAppRunnerIngressVPCConfiguration(connection.IngressVpcConfiguration)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting ingress_vpc_configuration: %w", err))
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for App Runner VPC Ingress Connection (%s): %s", arn, err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceVPCIngressConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	if d.HasChange("ingress_vpc_configuration") {
+		input := &apprunner.UpdateVpcIngressConnectionInput{
+			IngressVpcConfiguration: expandAppRunnerIngressVPCConfiguration(d.Get("ingress_vpc_configuration").([]interface{})),
+			VpcIngressConnectionArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateVpcIngressConnectionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating App Runner VPC Ingress Connection (%s): %w", d.Id(), err))
+		}
+
+		if err := WaitVPCIngressConnectionAvailable(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for App Runner VPC Ingress Connection (%s) to update: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating App Runner VPC Ingress Connection (%s) tags: %s", d.Get("arn").(string), err))
+		}
+	}
+
+	return resourceVPCIngressConnectionRead(ctx, d, meta)
+}
+
+func resourceVPCIngressConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	input := &apprunner.DeleteVpcIngressConnectionInput{
+		VpcIngressConnectionArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteVpcIngressConnectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting App Runner VPC Ingress Connection (%s): %w", d.Id(), err))
+	}
+
+	if err := WaitVPCIngressConnectionDeleted(ctx, conn, d.Id()); err != nil {
+		if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error waiting for App Runner VPC Ingress Connection (%s) deletion: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandAppRunnerIngressVPCConfiguration(l []interface{}) *apprunner.IngressVpcConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	result := &apprunner.IngressVpcConfiguration{}
+
+	if v, ok := tfMap["vpc_endpoint_id"].(string); ok && v != "" {
+		result.VpcEndpointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_id"].(string); ok && v != "" {
+		result.VpcId = aws.String(v)
+	}
+
+	return result
+}
+
+func flattenAppRunnerIngressVPCConfiguration(config *apprunner.IngressVpcConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"vpc_endpoint_id": aws.StringValue(config.VpcEndpointId),
+		"vpc_id":          aws.StringValue(config.VpcId),
+	}
+
+	return []interface{}{m}
+}
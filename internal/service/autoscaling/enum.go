@@ -3,3 +3,17 @@ package autoscaling
 const (
 	TagResourceTypeGroup = `auto-scaling-group`
 )
+
+const (
+	trafficSourceTypeELB        = "elb"
+	trafficSourceTypeELBV2      = "elbv2"
+	trafficSourceTypeVPCLattice = "vpc-lattice"
+)
+
+func trafficSourceType_Values() []string {
+	return []string{
+		trafficSourceTypeELB,
+		trafficSourceTypeELBV2,
+		trafficSourceTypeVPCLattice,
+	}
+}
@@ -42,6 +42,7 @@ func ResourceGroup() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -384,6 +385,25 @@ func ResourceGroup() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"traffic_source": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(trafficSourceType_Values(), false),
+						},
+					},
+				},
+			},
+
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -528,6 +548,10 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"auto_rollback": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
 									"checkpoint_delay": {
 										Type:         nullable.TypeNullableInt,
 										Optional:     true,
@@ -551,6 +575,11 @@ func ResourceGroup() *schema.Resource {
 										Default:      90,
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
+									"skip_matching": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
 								},
 							},
 						},
@@ -563,6 +592,11 @@ func ResourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateAutoScalingGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -589,6 +623,20 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Default:  -1,
 						},
+						"instance_reuse_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"reuse_on_scale_in": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -748,6 +796,10 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 		createOpts.TargetGroupARNs = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("traffic_source"); ok && v.(*schema.Set).Len() > 0 {
+		createOpts.TrafficSources = expandTrafficSourceIdentifiers(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("service_linked_role_arn"); ok {
 		createOpts.ServiceLinkedRoleARN = aws.String(v.(string))
 	}
@@ -919,10 +971,20 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := d.Set("target_group_arns", flex.FlattenStringList(g.TargetGroupARNs)); err != nil {
+	targetGroupARNs, err := filterTargetGroupARNs(conn, g)
+
+	if err != nil {
+		return fmt.Errorf("error reading Auto Scaling Group (%s) load balancer target groups: %w", d.Id(), err)
+	}
+
+	if err := d.Set("target_group_arns", flex.FlattenStringList(targetGroupARNs)); err != nil {
 		return fmt.Errorf("error setting target_group_arns: %s", err)
 	}
 
+	if err := d.Set("traffic_source", flattenTrafficSourceIdentifiers(filterTrafficSourceIdentifiers(g, d.Get("traffic_source").(*schema.Set)))); err != nil {
+		return fmt.Errorf("error setting traffic_source: %w", err)
+	}
+
 	// If no termination polices are explicitly configured and the upstream state
 	// is only using the "Default" policy, clear the state to make it consistent
 	// with the default AWS create API behavior.
@@ -1283,6 +1345,68 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("traffic_source") {
+		o, n := d.GetChange("traffic_source")
+		if o == nil {
+			o = new(schema.Set)
+		}
+		if n == nil {
+			n = new(schema.Set)
+		}
+
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := expandTrafficSourceIdentifiers(os.Difference(ns).List())
+		add := expandTrafficSourceIdentifiers(ns.Difference(os).List())
+
+		// AWS API only supports adding/removing 10 at a time
+		batchSize := 10
+
+		for len(remove) > 0 {
+			var batch []*autoscaling.TrafficSourceIdentifier
+			if len(remove) > batchSize {
+				batch, remove = remove[:batchSize], remove[batchSize:]
+			} else {
+				batch, remove = remove, nil
+			}
+
+			_, err := conn.DetachTrafficSources(&autoscaling.DetachTrafficSourcesInput{
+				AutoScalingGroupName: aws.String(d.Id()),
+				TrafficSources:       batch,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error detaching Auto Scaling Group (%s) traffic sources: %w", d.Id(), err)
+			}
+
+			if err := waitTrafficSourcesRemoved(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Auto Scaling Group (%s) traffic sources to be removed: %w", d.Id(), err)
+			}
+		}
+
+		for len(add) > 0 {
+			var batch []*autoscaling.TrafficSourceIdentifier
+			if len(add) > batchSize {
+				batch, add = add[:batchSize], add[batchSize:]
+			} else {
+				batch, add = add, nil
+			}
+
+			_, err := conn.AttachTrafficSources(&autoscaling.AttachTrafficSourcesInput{
+				AutoScalingGroupName: aws.String(d.Id()),
+				TrafficSources:       batch,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error attaching Auto Scaling Group (%s) traffic sources: %w", d.Id(), err)
+			}
+
+			if err := waitTrafficSourcesAdded(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Auto Scaling Group (%s) traffic sources to be added: %w", d.Id(), err)
+			}
+		}
+	}
+
 	if instanceRefreshRaw, ok := d.GetOk("instance_refresh"); ok {
 		instanceRefresh := instanceRefreshRaw.([]interface{})
 		if !shouldRefreshInstances {
@@ -1303,9 +1427,17 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 		if shouldRefreshInstances {
-			if err := autoScalingGroupRefreshInstances(conn, d.Id(), instanceRefresh); err != nil {
+			instanceRefreshID, err := autoScalingGroupRefreshInstances(conn, d.Id(), instanceRefresh)
+
+			if err != nil {
 				return fmt.Errorf("failed to start instance refresh of Auto Scaling Group %s: %w", d.Id(), err)
 			}
+
+			if d.Get("instance_refresh.0.wait_for_completion").(bool) {
+				if _, err := waitInstanceRefreshSuccessful(conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Instance Refresh (%s) on Auto Scaling Group (%s) to complete: %w", instanceRefreshID, d.Id(), err)
+				}
+			}
 		}
 	}
 
@@ -2083,6 +2215,19 @@ func FlattenWarmPoolConfiguration(warmPoolConfiguration *autoscaling.WarmPoolCon
 		"pool_state":                  aws.StringValue(warmPoolConfiguration.PoolState),
 		"min_size":                    aws.Int64Value(warmPoolConfiguration.MinSize),
 		"max_group_prepared_capacity": maxGroupPreparedCapacity,
+		"instance_reuse_policy":       flattenWarmPoolInstanceReusePolicy(warmPoolConfiguration.InstanceReusePolicy),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWarmPoolInstanceReusePolicy(instanceReusePolicy *autoscaling.InstanceReusePolicy) []interface{} {
+	if instanceReusePolicy == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"reuse_on_scale_in": aws.BoolValue(instanceReusePolicy.ReuseOnScaleIn),
 	}
 
 	return []interface{}{m}
@@ -2183,9 +2328,153 @@ func CreatePutWarmPoolInput(asgName string, l []interface{}) *autoscaling.PutWar
 		input.MaxGroupPreparedCapacity = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["instance_reuse_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		input.InstanceReusePolicy = &autoscaling.InstanceReusePolicy{
+			ReuseOnScaleIn: aws.Bool(tfMap["reuse_on_scale_in"].(bool)),
+		}
+	}
+
 	return &input
 }
 
+func expandTrafficSourceIdentifiers(tfList []interface{}) []*autoscaling.TrafficSourceIdentifier {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*autoscaling.TrafficSourceIdentifier
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &autoscaling.TrafficSourceIdentifier{}
+
+		if v, ok := tfMap["identifier"].(string); ok && v != "" {
+			apiObject.Identifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTrafficSourceIdentifiers(apiObjects []*autoscaling.TrafficSourceIdentifier) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"identifier": aws.StringValue(apiObject.Identifier),
+			"type":       aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+// filterTrafficSourceIdentifiers removes Classic Load Balancers and target groups that are
+// already managed through load_balancers or target_group_arns, unless they are also
+// configured as traffic sources, to avoid perpetual differences.
+func filterTrafficSourceIdentifiers(g *autoscaling.Group, configured *schema.Set) []*autoscaling.TrafficSourceIdentifier {
+	legacy := make(map[string]bool)
+
+	for _, v := range g.LoadBalancerNames {
+		legacy[aws.StringValue(v)] = true
+	}
+
+	for _, v := range g.TargetGroupARNs {
+		legacy[aws.StringValue(v)] = true
+	}
+
+	inConfig := make(map[string]bool)
+
+	for _, tfMapRaw := range configured.List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			inConfig[tfMap["identifier"].(string)] = true
+		}
+	}
+
+	var apiObjects []*autoscaling.TrafficSourceIdentifier
+
+	for _, apiObject := range g.TrafficSources {
+		if apiObject == nil {
+			continue
+		}
+
+		identifier := aws.StringValue(apiObject.Identifier)
+
+		if legacy[identifier] && !inConfig[identifier] {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// filterTargetGroupARNs removes target groups attached as traffic sources from the
+// Auto Scaling group's target group ARNs. DescribeLoadBalancerTargetGroups only
+// returns target groups attached through AttachLoadBalancerTargetGroups.
+func filterTargetGroupARNs(conn *autoscaling.AutoScaling, g *autoscaling.Group) ([]*string, error) {
+	if len(g.TrafficSources) == 0 {
+		return g.TargetGroupARNs, nil
+	}
+
+	input := &autoscaling.DescribeLoadBalancerTargetGroupsInput{
+		AutoScalingGroupName: g.AutoScalingGroupName,
+	}
+	attached := make(map[string]bool)
+
+	err := conn.DescribeLoadBalancerTargetGroupsPages(input, func(page *autoscaling.DescribeLoadBalancerTargetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LoadBalancerTargetGroups {
+			if v == nil {
+				continue
+			}
+
+			attached[aws.StringValue(v.LoadBalancerTargetGroupARN)] = true
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var targetGroupARNs []*string
+
+	for _, v := range g.TargetGroupARNs {
+		if attached[aws.StringValue(v)] {
+			targetGroupARNs = append(targetGroupARNs, v)
+		}
+	}
+
+	return targetGroupARNs, nil
+}
+
 func CreateGroupInstanceRefreshInput(asgName string, l []interface{}) *autoscaling.StartInstanceRefreshInput {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2209,6 +2498,10 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 
 	refreshPreferences := &autoscaling.RefreshPreferences{}
 
+	if v, ok := m["auto_rollback"].(bool); ok && v {
+		refreshPreferences.AutoRollback = aws.Bool(v)
+	}
+
 	if v, ok := m["checkpoint_delay"]; ok {
 		if v, null, _ := nullable.Int(v.(string)).Value(); !null {
 			refreshPreferences.CheckpointDelay = aws.Int64(v)
@@ -2233,13 +2526,19 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 		refreshPreferences.MinHealthyPercentage = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["skip_matching"].(bool); ok && v {
+		refreshPreferences.SkipMatching = aws.Bool(v)
+	}
+
 	return refreshPreferences
 }
 
-func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, refreshConfig []interface{}) error {
+func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, refreshConfig []interface{}) (string, error) {
 	input := CreateGroupInstanceRefreshInput(asgName, refreshConfig)
+	var output *autoscaling.StartInstanceRefreshOutput
 	err := resource.Retry(instanceRefreshStartedTimeout, func() *resource.RetryError {
-		_, err := conn.StartInstanceRefresh(input)
+		var err error
+		output, err = conn.StartInstanceRefresh(input)
 		if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeInstanceRefreshInProgressFault) {
 			cancelErr := cancelAutoscalingInstanceRefresh(conn, asgName)
 			if cancelErr != nil {
@@ -2253,13 +2552,13 @@ func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName str
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		_, err = conn.StartInstanceRefresh(input)
+		output, err = conn.StartInstanceRefresh(input)
 	}
 	if err != nil {
-		return fmt.Errorf("error starting Instance Refresh: %w", err)
+		return "", fmt.Errorf("error starting Instance Refresh: %w", err)
 	}
 
-	return nil
+	return aws.StringValue(output.InstanceRefreshId), nil
 }
 
 func cancelAutoscalingInstanceRefresh(conn *autoscaling.AutoScaling, asgName string) error {
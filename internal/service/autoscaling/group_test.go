@@ -866,6 +866,60 @@ func TestAccAutoScalingGroup_targetGroupARNs(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_trafficSources(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_TrafficSources(rName, 11),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "traffic_source.#", "11"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "traffic_source.*", map[string]string{
+						"type": "elbv2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete",
+					"initial_lifecycle_hook",
+					"tag",
+					"tags",
+					"traffic_source",
+					"wait_for_capacity_timeout",
+					"wait_for_elb_capacity",
+				},
+			},
+			{
+				Config: testAccGroupConfig_TrafficSources(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "traffic_source.#", "0"),
+				),
+			},
+			{
+				Config: testAccGroupConfig_TrafficSources(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "traffic_source.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "traffic_source.*.identifier", "aws_lb_target_group.test.0", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_initialLifecycleHook(t *testing.T) {
 	var group autoscaling.Group
 
@@ -1002,6 +1056,7 @@ func TestAccAutoScalingGroup_InstanceRefresh_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.2", "25"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.3", "50"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.4", "100"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.skip_matching", "true"),
 				),
 			},
 			{
@@ -1057,6 +1112,36 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_InstanceRefresh_waitForCompletion(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_InstanceRefresh_WaitForCompletion("one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.wait_for_completion", "true"),
+					testAccCheckAutoScalingInstanceRefreshCount(&group, 0),
+				),
+			},
+			{
+				Config: testAccGroupConfig_InstanceRefresh_WaitForCompletion("two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					testAccCheckAutoScalingInstanceRefreshCount(&group, 1),
+					testAccCheckAutoScalingInstanceRefreshStatus(&group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_InstanceRefresh_triggers(t *testing.T) {
 	var group autoscaling.Group
 	resourceName := "aws_autoscaling_group.test"
@@ -1125,6 +1210,8 @@ func TestAccAutoScalingGroup_warmPool(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.pool_state", "Stopped"),
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.min_size", "0"),
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.max_group_prepared_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.instance_reuse_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.instance_reuse_policy.0.reuse_on_scale_in", "true"),
 				),
 			},
 			{
@@ -3396,6 +3483,72 @@ resource "aws_autoscaling_group" "test" {
 `, rName, tgCount)
 }
 
+func testAccGroupConfig_TrafficSources(rName string, tgCount int) string {
+	return acctest.ConfigAvailableAZsNoOptInDefaultExclude() +
+		fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_template" "test" {
+  image_id      = data.aws_ami.test.id
+  instance_type = "t3.micro"
+  name          = %[1]q
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  count = %[2]d
+
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+}
+
+resource "aws_autoscaling_group" "test" {
+  force_delete        = true
+  max_size            = 0
+  min_size            = 0
+  vpc_zone_identifier = [aws_subnet.test.id]
+
+  dynamic "traffic_source" {
+    for_each = aws_lb_target_group.test[*]
+    content {
+      identifier = traffic_source.value.arn
+      type       = "elbv2"
+    }
+  }
+
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+}
+`, rName, tgCount)
+}
+
 func testAccGroupWithHookConfig(name string) string {
 	return acctest.ConfigAvailableAZsNoOptInDefaultExclude() +
 		fmt.Sprintf(`
@@ -4546,6 +4699,7 @@ resource "aws_autoscaling_group" "test" {
       min_healthy_percentage = 50
       checkpoint_delay       = 25
       checkpoint_percentages = [1, 20, 25, 50, 100]
+      skip_matching          = true
     }
   }
 }
@@ -4657,6 +4811,57 @@ resource "aws_launch_configuration" "test" {
 `, launchConfigurationName)
 }
 
+func testAccGroupConfig_InstanceRefresh_WaitForCompletion(launchConfigurationName string) string {
+	return fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.current.names[0]]
+  max_size             = 2
+  min_size             = 1
+  desired_capacity     = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  instance_refresh {
+    strategy            = "Rolling"
+    wait_for_completion = true
+
+    preferences {
+      instance_warmup        = 0
+      min_healthy_percentage = 0
+    }
+  }
+}
+
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+data "aws_availability_zones" "current" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name_prefix   = %[1]q
+  image_id      = data.aws_ami.test.id
+  instance_type = "t3.nano"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, launchConfigurationName)
+}
+
 func testAccGroupConfig_InstanceRefresh_Triggers() string {
 	return `
 resource "aws_autoscaling_group" "test" {
@@ -4759,6 +4964,10 @@ resource "aws_autoscaling_group" "test" {
     pool_state                  = "Stopped"
     min_size                    = 0
     max_group_prepared_capacity = 2
+
+    instance_reuse_policy {
+      reuse_on_scale_in = true
+    }
   }
 }
 `
@@ -5072,6 +5281,43 @@ func TestCreateAutoScalingGroupInstanceRefreshInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "auto_rollback and skip_matching",
+			input: []interface{}{map[string]interface{}{
+				"strategy": "Rolling",
+				"preferences": []interface{}{
+					map[string]interface{}{
+						"auto_rollback": true,
+						"skip_matching": true,
+					},
+				},
+			}},
+			expected: &autoscaling.StartInstanceRefreshInput{
+				AutoScalingGroupName: aws.String(asgName),
+				Strategy:             aws.String("Rolling"),
+				Preferences: &autoscaling.RefreshPreferences{
+					AutoRollback: aws.Bool(true),
+					SkipMatching: aws.Bool(true),
+				},
+			},
+		},
+		{
+			name: "auto_rollback and skip_matching false",
+			input: []interface{}{map[string]interface{}{
+				"strategy": "Rolling",
+				"preferences": []interface{}{
+					map[string]interface{}{
+						"auto_rollback": false,
+						"skip_matching": false,
+					},
+				},
+			}},
+			expected: &autoscaling.StartInstanceRefreshInput{
+				AutoScalingGroupName: aws.String(asgName),
+				Strategy:             aws.String("Rolling"),
+				Preferences:          &autoscaling.RefreshPreferences{},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -5152,6 +5398,22 @@ func TestPutWarmPoolInput(t *testing.T) {
 				MaxGroupPreparedCapacity: aws.Int64(2),
 			},
 		},
+		{
+			name: "instance reuse policy",
+			input: []interface{}{map[string]interface{}{
+				"pool_state": "Hibernated",
+				"instance_reuse_policy": []interface{}{map[string]interface{}{
+					"reuse_on_scale_in": true,
+				}},
+			}},
+			expected: &autoscaling.PutWarmPoolInput{
+				AutoScalingGroupName: aws.String(asgName),
+				PoolState:            aws.String("Hibernated"),
+				InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+					ReuseOnScaleIn: aws.Bool(true),
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -5185,6 +5447,7 @@ func TestFlattenWarmPoolConfiguration(t *testing.T) {
 				"pool_state":                  "Stopped",
 				"min_size":                    int64(0),
 				"max_group_prepared_capacity": int64(-1),
+				"instance_reuse_policy":       []interface{}{},
 			}},
 		},
 		{
@@ -5197,6 +5460,7 @@ func TestFlattenWarmPoolConfiguration(t *testing.T) {
 				"pool_state":                  "Stopped",
 				"min_size":                    int64(0),
 				"max_group_prepared_capacity": int64(2),
+				"instance_reuse_policy":       []interface{}{},
 			}},
 		},
 		{
@@ -5210,6 +5474,24 @@ func TestFlattenWarmPoolConfiguration(t *testing.T) {
 				"pool_state":                  "Stopped",
 				"min_size":                    int64(3),
 				"max_group_prepared_capacity": int64(5),
+				"instance_reuse_policy":       []interface{}{},
+			}},
+		},
+		{
+			name: "instance reuse policy",
+			input: &autoscaling.WarmPoolConfiguration{
+				PoolState: aws.String("Hibernated"),
+				InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+					ReuseOnScaleIn: aws.Bool(true),
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"pool_state":                  "Hibernated",
+				"min_size":                    int64(0),
+				"max_group_prepared_capacity": int64(-1),
+				"instance_reuse_policy": []interface{}{map[string]interface{}{
+					"reuse_on_scale_in": true,
+				}},
 			}},
 		},
 	}
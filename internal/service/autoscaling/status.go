@@ -26,3 +26,36 @@ func statusInstanceRefresh(conn *autoscaling.AutoScaling, asgName, instanceRefre
 		return instanceRefresh, aws.StringValue(instanceRefresh.Status), nil
 	}
 }
+
+// statusTrafficSources returns state while any traffic source attached to the
+// Auto Scaling group is in that state, otherwise trafficSourcesStatusSettled.
+func statusTrafficSources(conn *autoscaling.AutoScaling, asgName, state string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &autoscaling.DescribeTrafficSourcesInput{
+			AutoScalingGroupName: aws.String(asgName),
+		}
+		var trafficSources []*autoscaling.TrafficSourceState
+
+		err := conn.DescribeTrafficSourcesPages(input, func(page *autoscaling.DescribeTrafficSourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			trafficSources = append(trafficSources, page.TrafficSources...)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range trafficSources {
+			if v != nil && aws.StringValue(v.State) == state {
+				return trafficSources, state, nil
+			}
+		}
+
+		return trafficSources, trafficSourcesStatusSettled, nil
+	}
+}
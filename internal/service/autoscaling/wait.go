@@ -1,10 +1,13 @@
 package autoscaling
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -15,6 +18,12 @@ const (
 
 	// Maximum amount of time to wait for an Instance Refresh to be Cancelled
 	instanceRefreshCancelledTimeout = 15 * time.Minute
+
+	trafficSourcesDelay = 5 * time.Second
+
+	trafficSourceStateAdding    = "Adding"
+	trafficSourceStateRemoving  = "Removing"
+	trafficSourcesStatusSettled = "Settled"
 )
 
 func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instanceRefreshId string) (*autoscaling.InstanceRefresh, error) {
@@ -42,3 +51,55 @@ func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instan
 
 	return nil, err
 }
+
+func waitInstanceRefreshSuccessful(conn *autoscaling.AutoScaling, asgName, instanceRefreshId string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusInProgress,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: statusInstanceRefresh(conn, asgName, instanceRefreshId),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		if reason := aws.StringValue(v.StatusReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitTrafficSourcesAdded(conn *autoscaling.AutoScaling, asgName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{trafficSourceStateAdding},
+		Target:  []string{trafficSourcesStatusSettled},
+		Refresh: statusTrafficSources(conn, asgName, trafficSourceStateAdding),
+		Timeout: timeout,
+		Delay:   trafficSourcesDelay,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitTrafficSourcesRemoved(conn *autoscaling.AutoScaling, asgName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{trafficSourceStateRemoving},
+		Target:  []string{trafficSourcesStatusSettled},
+		Refresh: statusTrafficSources(conn, asgName, trafficSourceStateRemoving),
+		Timeout: timeout,
+		Delay:   trafficSourcesDelay,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
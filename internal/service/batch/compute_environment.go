@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -66,7 +67,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"allocation_strategy": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							StateFunc: func(val interface{}) string {
 								return strings.ToUpper(val.(string))
 							},
@@ -75,7 +75,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"bid_percentage": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 						"desired_vcpus": {
 							Type:     schema.TypeInt,
@@ -86,7 +85,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -94,13 +92,11 @@ func ResourceComputeEnvironment() *schema.Resource {
 										Type:         schema.TypeString,
 										Optional:     true,
 										Computed:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.StringLenBetween(1, 256),
 									},
 									"image_type": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.StringLenBetween(1, 256),
 									},
 								},
@@ -109,48 +105,40 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"ec2_key_pair": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"image_id": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"instance_role": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: verify.ValidARN,
 						},
 						"instance_type": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"launch_template": {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"launch_template_id": {
 										Type:          schema.TypeString,
 										Optional:      true,
-										ForceNew:      true,
 										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_name"},
 									},
 									"launch_template_name": {
 										Type:          schema.TypeString,
 										Optional:      true,
-										ForceNew:      true,
 										ConflictsWith: []string{"compute_resources.0.launch_template.0.launch_template_id"},
 									},
 									"version": {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -179,11 +167,10 @@ func ResourceComputeEnvironment() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						"tags": tftags.TagsSchemaForceNew(),
+						"tags": tftags.TagsSchema(),
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							StateFunc: func(val interface{}) string {
 								return strings.ToUpper(val.(string))
 							},
@@ -230,6 +217,24 @@ func ResourceComputeEnvironment() *schema.Resource {
 				},
 				ValidateFunc: validation.StringInSlice(batch.CEType_Values(), true),
 			},
+			"update_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_execution_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+						"terminate_jobs_on_update": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -277,6 +282,23 @@ func resourceComputeEnvironmentCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error waiting for Batch Compute Environment (%s) create: %w", d.Id(), err)
 	}
 
+	// UpdatePolicy is not possible to set with CreateComputeEnvironment.
+	if v, ok := d.GetOk("update_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &batch.UpdateComputeEnvironmentInput{
+			ComputeEnvironment: aws.String(d.Id()),
+			UpdatePolicy:       expandBatchUpdatePolicy(v.([]interface{})[0].(map[string]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Batch Compute Environment: %s", input)
+		if _, err := conn.UpdateComputeEnvironment(input); err != nil {
+			return fmt.Errorf("error updating Batch Compute Environment (%s) update policy: %w", d.Id(), err)
+		}
+
+		if _, err := waitComputeEnvironmentUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Batch Compute Environment (%s) update: %w", d.Id(), err)
+		}
+	}
+
 	return resourceComputeEnvironmentRead(d, meta)
 }
 
@@ -320,6 +342,14 @@ func resourceComputeEnvironmentRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if computeEnvironment.UpdatePolicy != nil {
+		if err := d.Set("update_policy", []interface{}{flattenBatchUpdatePolicy(computeEnvironment.UpdatePolicy)}); err != nil {
+			return fmt.Errorf("error setting update_policy: %w", err)
+		}
+	} else {
+		d.Set("update_policy", nil)
+	}
+
 	tags := KeyValueTags(computeEnvironment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -350,6 +380,12 @@ func resourceComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 			input.State = aws.String(d.Get("state").(string))
 		}
 
+		if d.HasChange("update_policy") {
+			if v, ok := d.GetOk("update_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.UpdatePolicy = expandBatchUpdatePolicy(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		// TODO See above on how to remove check on type.
 		if computeEnvironmentType := strings.ToUpper(d.Get("type").(string)); computeEnvironmentType == batch.CETypeManaged {
 			// "At least one compute-resources attribute must be specified"
@@ -373,6 +409,57 @@ func resourceComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 				computeResourceUpdate.Subnets = flex.ExpandStringSet(d.Get("compute_resources.0.subnets").(*schema.Set))
 			}
 
+			// Any other changes to compute resources are only planned as in-place updates
+			// when the compute environment supports infrastructure updates (see CustomizeDiff).
+			if d.HasChange("compute_resources.0.allocation_strategy") {
+				computeResourceUpdate.AllocationStrategy = aws.String(d.Get("compute_resources.0.allocation_strategy").(string))
+			}
+
+			if d.HasChange("compute_resources.0.bid_percentage") {
+				computeResourceUpdate.BidPercentage = aws.Int64(int64(d.Get("compute_resources.0.bid_percentage").(int)))
+			}
+
+			if d.HasChange("compute_resources.0.ec2_configuration") {
+				computeResourceUpdate.Ec2Configuration = expandBatchEc2Configurations(d.Get("compute_resources.0.ec2_configuration").([]interface{}))
+			}
+
+			if d.HasChange("compute_resources.0.ec2_key_pair") {
+				// An empty string removes the EC2 key pair.
+				computeResourceUpdate.Ec2KeyPair = aws.String(d.Get("compute_resources.0.ec2_key_pair").(string))
+			}
+
+			if d.HasChange("compute_resources.0.image_id") {
+				// An empty string removes the AMI ID override.
+				computeResourceUpdate.ImageId = aws.String(d.Get("compute_resources.0.image_id").(string))
+			}
+
+			if d.HasChange("compute_resources.0.instance_role") {
+				computeResourceUpdate.InstanceRole = aws.String(d.Get("compute_resources.0.instance_role").(string))
+			}
+
+			if d.HasChange("compute_resources.0.instance_type") {
+				computeResourceUpdate.InstanceTypes = flex.ExpandStringSet(d.Get("compute_resources.0.instance_type").(*schema.Set))
+			}
+
+			if d.HasChange("compute_resources.0.launch_template") {
+				if v, ok := d.GetOk("compute_resources.0.launch_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					computeResourceUpdate.LaunchTemplate = expandBatchLaunchTemplateSpecification(v.([]interface{})[0].(map[string]interface{}))
+				} else {
+					// An empty launch template ID removes the launch template.
+					computeResourceUpdate.LaunchTemplate = &batch.LaunchTemplateSpecification{
+						LaunchTemplateId: aws.String(""),
+					}
+				}
+			}
+
+			if d.HasChange("compute_resources.0.tags") {
+				computeResourceUpdate.Tags = Tags(tftags.New(d.Get("compute_resources.0.tags").(map[string]interface{})).IgnoreAWS())
+			}
+
+			if d.HasChange("compute_resources.0.type") {
+				computeResourceUpdate.Type = aws.String(d.Get("compute_resources.0.type").(string))
+			}
+
 			input.ComputeResources = computeResourceUpdate
 		}
 
@@ -444,15 +531,53 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 			fargateComputeResources = true
 		}
 
-		if diff.HasChange("compute_resources.0.security_group_ids") && !fargateComputeResources {
-			if err := diff.ForceNew("compute_resources.0.security_group_ids"); err != nil {
-				return err
+		updatableComputeEnvironment := isUpdatableComputeEnvironment(diff)
+
+		if !fargateComputeResources && !updatableComputeEnvironment {
+			for _, key := range []string{
+				"compute_resources.0.security_group_ids",
+				"compute_resources.0.subnets",
+			} {
+				if diff.HasChange(key) {
+					if err := diff.ForceNew(key); err != nil {
+						return err
+					}
+				}
 			}
 		}
 
-		if diff.HasChange("compute_resources.0.subnets") && !fargateComputeResources {
-			if err := diff.ForceNew("compute_resources.0.subnets"); err != nil {
-				return err
+		if !updatableComputeEnvironment {
+			for _, key := range []string{
+				"compute_resources.0.allocation_strategy",
+				"compute_resources.0.bid_percentage",
+				"compute_resources.0.ec2_configuration",
+				"compute_resources.0.ec2_configuration.0.image_id_override",
+				"compute_resources.0.ec2_configuration.0.image_type",
+				"compute_resources.0.ec2_key_pair",
+				"compute_resources.0.image_id",
+				"compute_resources.0.instance_role",
+				"compute_resources.0.instance_type",
+				"compute_resources.0.launch_template",
+				"compute_resources.0.launch_template.0.launch_template_id",
+				"compute_resources.0.launch_template.0.launch_template_name",
+				"compute_resources.0.launch_template.0.version",
+				"compute_resources.0.tags",
+				"compute_resources.0.type",
+			} {
+				if diff.HasChange(key) {
+					if err := diff.ForceNew(key); err != nil {
+						return err
+					}
+				}
+			}
+		} else if diff.HasChange("compute_resources.0.type") {
+			// Only EC2 <-> SPOT changes can be made in place.
+			o, n := diff.GetChange("compute_resources.0.type")
+
+			if isFargateComputeResourceType(o.(string)) || isFargateComputeResourceType(n.(string)) {
+				if err := diff.ForceNew("compute_resources.0.type"); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -460,6 +585,47 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 	return nil
 }
 
+// isUpdatableComputeEnvironment returns whether the compute environment supports infrastructure updates.
+// See https://docs.aws.amazon.com/batch/latest/userguide/updating-compute-environments.html.
+func isUpdatableComputeEnvironment(diff *schema.ResourceDiff) bool {
+	o, n := diff.GetChange("service_role")
+
+	if !isServiceLinkedRoleARN(o.(string)) || !isServiceLinkedRoleARN(n.(string)) {
+		return false
+	}
+
+	o, n = diff.GetChange("compute_resources.0.allocation_strategy")
+
+	return isUpdatableAllocationStrategy(o.(string)) && isUpdatableAllocationStrategy(n.(string))
+}
+
+func isServiceLinkedRoleARN(v string) bool {
+	// An unconfigured service role defaults to the AWS Batch service-linked role.
+	if v == "" {
+		return true
+	}
+
+	return regexp.MustCompile(`^arn:[^:]+:iam::\d{12}:role/aws-service-role/batch\.amazonaws\.com/`).MatchString(v)
+}
+
+func isUpdatableAllocationStrategy(v string) bool {
+	switch strings.ToUpper(v) {
+	case batch.CRAllocationStrategyBestFitProgressive, batch.CRAllocationStrategySpotCapacityOptimized, batch.CRAllocationStrategySpotPriceCapacityOptimized:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFargateComputeResourceType(v string) bool {
+	switch strings.ToUpper(v) {
+	case batch.CRTypeFargate, batch.CRTypeFargateSpot:
+		return true
+	default:
+		return false
+	}
+}
+
 func expandBatchComputeResource(tfMap map[string]interface{}) *batch.ComputeResource {
 	if tfMap == nil {
 		return nil
@@ -739,3 +905,39 @@ func flattenBatchLaunchTemplateSpecification(apiObject *batch.LaunchTemplateSpec
 
 	return tfMap
 }
+
+func expandBatchUpdatePolicy(tfMap map[string]interface{}) *batch.UpdatePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.UpdatePolicy{}
+
+	if v, ok := tfMap["job_execution_timeout_minutes"].(int); ok && v != 0 {
+		apiObject.JobExecutionTimeoutMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["terminate_jobs_on_update"].(bool); ok {
+		apiObject.TerminateJobsOnUpdate = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenBatchUpdatePolicy(apiObject *batch.UpdatePolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.JobExecutionTimeoutMinutes; v != nil {
+		tfMap["job_execution_timeout_minutes"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TerminateJobsOnUpdate; v != nil {
+		tfMap["terminate_jobs_on_update"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/batch"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -1323,6 +1324,91 @@ func TestAccBatchComputeEnvironment_tags(t *testing.T) {
 	})
 }
 
+func TestAccBatchComputeEnvironment_updatePolicy(t *testing.T) {
+	var ce batch.ComputeEnvironmentDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_compute_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			acctest.PreCheckIAMServiceLinkedRole(t, "/aws-service-role/batch")
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeEnvironmentUpdatePolicyConfig(rName, 30, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(resourceName, &ce),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.job_execution_timeout_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.terminate_jobs_on_update", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeEnvironmentUpdatePolicyConfig(rName, 60, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(resourceName, &ce),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.job_execution_timeout_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.terminate_jobs_on_update", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchComputeEnvironment_UpdateEC2_inPlace(t *testing.T) {
+	var ce1, ce2 batch.ComputeEnvironmentDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_compute_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			acctest.PreCheckIAMServiceLinkedRole(t, "/aws-service-role/batch")
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeEnvironmentEC2UpdatableConfig(rName, "BEST_FIT_PROGRESSIVE", "c4.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(resourceName, &ce1),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.allocation_strategy", "BEST_FIT_PROGRESSIVE"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_type.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compute_resources.0.instance_type.*", "c4.large"),
+				),
+			},
+			{
+				Config: testAccComputeEnvironmentEC2UpdatableConfig(rName, "BEST_FIT_PROGRESSIVE", "c5.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeEnvironmentExists(resourceName, &ce2),
+					testAccCheckComputeEnvironmentNotRecreated(&ce1, &ce2),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.allocation_strategy", "BEST_FIT_PROGRESSIVE"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_type.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compute_resources.0.instance_type.*", "c5.large"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccBatchComputeEnvironment_createUnmanagedWithComputeResources(t *testing.T) {
 	var ce batch.ComputeEnvironmentDetail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1441,6 +1527,16 @@ func testAccCheckComputeEnvironmentExists(n string, v *batch.ComputeEnvironmentD
 	}
 }
 
+func testAccCheckComputeEnvironmentNotRecreated(i, j *batch.ComputeEnvironmentDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.EcsClusterArn) != aws.StringValue(j.EcsClusterArn) {
+			return fmt.Errorf("Batch Compute Environment recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn
 
@@ -2180,3 +2276,66 @@ resource "aws_batch_compute_environment" "test" {
 }
 `, rName))
 }
+
+func testAccComputeEnvironmentUpdatePolicyConfig(rName string, timeout int, terminate bool) string {
+	return acctest.ConfigCompose(
+		testAccComputeEnvironmentBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_compute_environment" "test" {
+  compute_environment_name = %[1]q
+
+  compute_resources {
+    allocation_strategy = "BEST_FIT_PROGRESSIVE"
+    instance_role       = aws_iam_instance_profile.ecs_instance.arn
+    instance_type = [
+      "optimal",
+    ]
+    max_vcpus = 4
+    min_vcpus = 0
+    security_group_ids = [
+      aws_security_group.test.id
+    ]
+    subnets = [
+      aws_subnet.test.id
+    ]
+    type = "EC2"
+  }
+
+  update_policy {
+    job_execution_timeout_minutes = %[2]d
+    terminate_jobs_on_update      = %[3]t
+  }
+
+  type = "MANAGED"
+}
+`, rName, timeout, terminate))
+}
+
+func testAccComputeEnvironmentEC2UpdatableConfig(rName, allocationStrategy, instanceType string) string {
+	return acctest.ConfigCompose(
+		testAccComputeEnvironmentBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_compute_environment" "test" {
+  compute_environment_name = %[1]q
+
+  compute_resources {
+    allocation_strategy = %[2]q
+    instance_role       = aws_iam_instance_profile.ecs_instance.arn
+    instance_type = [
+      %[3]q,
+    ]
+    max_vcpus = 4
+    min_vcpus = 0
+    security_group_ids = [
+      aws_security_group.test.id
+    ]
+    subnets = [
+      aws_subnet.test.id
+    ]
+    type = "EC2"
+  }
+
+  type = "MANAGED"
+}
+`, rName, allocationStrategy, instanceType))
+}
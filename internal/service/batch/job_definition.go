@@ -130,6 +130,11 @@ func ResourceJobDefinition() *schema.Resource {
 					},
 				},
 			},
+			"scheduling_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"propagate_tags": {
@@ -207,6 +212,10 @@ func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error
 		input.RetryStrategy = expandBatchRetryStrategy(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("scheduling_priority"); ok {
+		input.SchedulingPriority = aws.Int64(int64(v.(int)))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -259,6 +268,7 @@ func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("parameters", aws.StringValueMap(jobDefinition.Parameters))
 	d.Set("platform_capabilities", aws.StringValueSlice(jobDefinition.PlatformCapabilities))
 	d.Set("propagate_tags", jobDefinition.PropagateTags)
+	d.Set("scheduling_priority", jobDefinition.SchedulingPriority)
 
 	if jobDefinition.RetryStrategy != nil {
 		if err := d.Set("retry_strategy", []interface{}{flattenBatchRetryStrategy(jobDefinition.RetryStrategy)}); err != nil {
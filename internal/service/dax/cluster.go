@@ -160,6 +160,10 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"configuration_endpoint_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"cluster_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -335,6 +339,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("port", c.ClusterDiscoveryEndpoint.Port)
 		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.StringValue(c.ClusterDiscoveryEndpoint.Address), aws.Int64Value(c.ClusterDiscoveryEndpoint.Port)))
 		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+		d.Set("configuration_endpoint_url", c.ClusterDiscoveryEndpoint.URL)
 	}
 
 	d.Set("subnet_group_name", c.SubnetGroup)
@@ -53,6 +53,8 @@ func TestAccDAXCluster_basic(t *testing.T) {
 						resourceName, "nodes.0.id", regexp.MustCompile(`^tf-[\w-]+$`)),
 					resource.TestMatchResourceAttr(
 						resourceName, "configuration_endpoint", regexp.MustCompile(`:\d+$`)),
+					resource.TestMatchResourceAttr(
+						resourceName, "configuration_endpoint_url", regexp.MustCompile(`^dax://`)),
 					resource.TestCheckResourceAttrSet(
 						resourceName, "cluster_address"),
 					resource.TestMatchResourceAttr(
@@ -235,6 +237,7 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(resourceName, &dc),
 					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", clusterEndpointEncryptionType),
+					resource.TestMatchResourceAttr(resourceName, "configuration_endpoint_url", regexp.MustCompile(`^daxs://`)),
 				),
 			},
 			{
@@ -0,0 +1,163 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceContributorInsights() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContributorInsightsCreate,
+		ReadWithoutTimeout:   resourceContributorInsightsRead,
+		DeleteWithoutTimeout: resourceContributorInsightsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceContributorInsightsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	indexName := d.Get("index_name").(string)
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionEnable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	_, err := conn.UpdateContributorInsightsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error enabling DynamoDB Contributor Insights (table: %s, index: %s): %w", tableName, indexName, err))
+	}
+
+	d.SetId(ContributorInsightsCreateID(tableName, indexName))
+
+	if _, err := waitDynamoDBContributorInsightsEnabled(ctx, conn, tableName, indexName); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be enabled: %w", d.Id(), err))
+	}
+
+	return resourceContributorInsightsRead(ctx, d, meta)
+}
+
+func resourceContributorInsightsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, indexName, err := ContributorInsightsParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindDynamoDBContributorInsights(ctx, conn, tableName, indexName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading DynamoDB Contributor Insights (%s): %w", d.Id(), err))
+	}
+
+	if output == nil || aws.StringValue(output.ContributorInsightsStatus) == dynamodb.ContributorInsightsStatusDisabled {
+		if d.IsNewResource() {
+			return diag.FromErr(fmt.Errorf("error reading DynamoDB Contributor Insights (%s): empty output after creation", d.Id()))
+		}
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("index_name", output.IndexName)
+	d.Set("table_name", output.TableName)
+
+	return nil
+}
+
+func resourceContributorInsightsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, indexName, err := ContributorInsightsParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionDisable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Disabling DynamoDB Contributor Insights: %s", d.Id())
+	_, err = conn.UpdateContributorInsightsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling DynamoDB Contributor Insights (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waitDynamoDBContributorInsightsDisabled(ctx, conn, tableName, indexName); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be disabled: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func ContributorInsightsCreateID(tableName, indexName string) string {
+	if indexName == "" {
+		return tableName
+	}
+
+	return strings.Join([]string{tableName, indexName}, ",")
+}
+
+func ContributorInsightsParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return parts[0], "", nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format of ID (%s), expected TABLE_NAME or TABLE_NAME,INDEX_NAME", id)
+}
@@ -119,3 +119,39 @@ func FindDynamoDBTTLRDescriptionByTableName(conn *dynamodb.DynamoDB, tableName s
 
 	return output.TimeToLiveDescription, nil
 }
+
+func FindDynamoDBImportTableDescriptionByARN(conn *dynamodb.DynamoDB, importARN string) (*dynamodb.ImportTableDescription, error) {
+	input := &dynamodb.DescribeImportInput{
+		ImportArn: aws.String(importARN),
+	}
+
+	output, err := conn.DescribeImport(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ImportTableDescription, nil
+}
+
+func FindDynamoDBContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	input := &dynamodb.DescribeContributorInsightsInput{
+		TableName: aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	output, err := conn.DescribeContributorInsightsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
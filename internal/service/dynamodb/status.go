@@ -184,3 +184,43 @@ func statusDynamoDBTableSES(conn *dynamodb.DynamoDB, tableName string) resource.
 		return table, aws.StringValue(table.SSEDescription.Status), nil
 	}
 }
+
+func statusDynamoDBImportTable(conn *dynamodb.DynamoDB, importARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		importTable, err := FindDynamoDBImportTableDescriptionByARN(conn, importARN)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeImportNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if importTable == nil {
+			return nil, "", nil
+		}
+
+		return importTable, aws.StringValue(importTable.ImportStatus), nil
+	}
+}
+
+func statusDynamoDBContributorInsights(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDynamoDBContributorInsights(ctx, conn, tableName, indexName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.ContributorInsightsStatus), nil
+	}
+}
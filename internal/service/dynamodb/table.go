@@ -129,6 +129,10 @@ func ResourceTable() *schema.Resource {
 				Default:      dynamodb.BillingModeProvisioned,
 				ValidateFunc: validation.StringInSlice(dynamodb.BillingMode_Values(), false),
 			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"global_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -173,6 +177,86 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -461,6 +545,65 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			return errors.New("error creating DynamoDB Table: empty response")
 		}
 
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		billingMode := d.Get("billing_mode").(string)
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		tcp := &dynamodb.TableCreationParameters{
+			TableName:             aws.String(d.Get("name").(string)),
+			BillingMode:           aws.String(billingMode),
+			KeySchema:             expandDynamoDbKeySchema(keySchemaMap),
+			ProvisionedThroughput: expandDynamoDbProvisionedThroughput(capacityMap, billingMode),
+		}
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			tcp.AttributeDefinitions = expandDynamoDbAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateDynamoDbGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return fmt.Errorf("failed to create GSI: %w", err)
+				}
+
+				gsiObject := expandDynamoDbGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			tcp.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			tcp.SSESpecification = expandDynamoDbEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		req := expandDynamoDbImportTable(v.([]interface{})[0].(map[string]interface{}))
+		req.TableCreationParameters = tcp
+
+		log.Printf("[DEBUG] Importing DynamoDB Table: %s", req)
+		output, err := conn.ImportTable(req)
+
+		if err != nil {
+			return fmt.Errorf("error creating DynamoDB Table (%s) from import: %w", d.Get("name").(string), err)
+		}
+
+		if output == nil || output.ImportTableDescription == nil {
+			return errors.New("error creating DynamoDB Table: empty response")
+		}
+
+		importARN := aws.StringValue(output.ImportTableDescription.ImportArn)
+
+		if _, err := waitDynamoDBImportTableCompleted(conn, importARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for DynamoDB Table (%s) import (%s): %w", d.Get("name").(string), importARN, err)
+		}
 	} else {
 		req := &dynamodb.CreateTableInput{
 			TableName:   aws.String(d.Get("name").(string)),
@@ -519,6 +662,10 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			req.TableClass = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("deletion_protection_enabled"); ok {
+			req.DeletionProtectionEnabled = aws.Bool(v.(bool))
+		}
+
 		var output *dynamodb.CreateTableOutput
 		err := resource.Retry(createTableTimeout, func() *resource.RetryError {
 			var err error
@@ -554,10 +701,61 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("name").(string))
 
-	if _, err := waitDynamoDBTableActive(conn, d.Id()); err != nil {
+	table, err := waitDynamoDBTableActive(conn, d.Id())
+
+	if err != nil {
 		return fmt.Errorf("error waiting for creation of DynamoDB table (%s): %w", d.Id(), err)
 	}
 
+	// RestoreTableToPointInTime and ImportTable do not accept all table settings,
+	// so apply the remaining ones once the table is active.
+	_, restoring := d.GetOk("restore_source_name")
+	_, importing := d.GetOk("import_table")
+
+	if restoring || importing {
+		hasTableUpdate := false
+		input := &dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("deletion_protection_enabled"); ok {
+			hasTableUpdate = true
+			input.DeletionProtectionEnabled = aws.Bool(v.(bool))
+		}
+
+		if importing {
+			if v, ok := d.GetOk("stream_enabled"); ok {
+				hasTableUpdate = true
+				input.StreamSpecification = &dynamodb.StreamSpecification{
+					StreamEnabled:  aws.Bool(v.(bool)),
+					StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+				}
+			}
+
+			if v, ok := d.GetOk("table_class"); ok && v.(string) != dynamodb.TableClassStandard {
+				hasTableUpdate = true
+				input.TableClass = aws.String(v.(string))
+			}
+		}
+
+		if hasTableUpdate {
+			log.Printf("[DEBUG] Updating DynamoDB Table: %s", input)
+			if _, err := conn.UpdateTable(input); err != nil {
+				return fmt.Errorf("error updating DynamoDB Table (%s): %w", d.Id(), err)
+			}
+
+			if _, err := waitDynamoDBTableActive(conn, d.Id()); err != nil {
+				return fmt.Errorf("error waiting for DynamoDB Table (%s) update: %w", d.Id(), err)
+			}
+		}
+
+		if importing && len(tags) > 0 && table != nil {
+			if err := UpdateTags(conn, aws.StringValue(table.TableArn), nil, tags); err != nil {
+				return fmt.Errorf("error adding DynamoDB Table (%s) tags: %w", d.Id(), err)
+			}
+		}
+	}
+
 	if d.Get("ttl.0.enabled").(bool) {
 		if err := updateDynamoDbTimeToLive(d.Id(), d.Get("ttl").([]interface{}), conn); err != nil {
 			return fmt.Errorf("error enabling DynamoDB Table (%s) Time to Live: %w", d.Id(), err)
@@ -565,7 +763,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.Get("point_in_time_recovery.0.enabled").(bool) {
-		if err := updateDynamoDbPITR(d, conn); err != nil {
+		if err := updateDynamoDbPITR(d.Id(), d.Get("point_in_time_recovery.0.enabled").(bool), conn); err != nil {
 			return fmt.Errorf("error enabling DynamoDB Table (%s) point in time recovery: %w", d.Id(), err)
 		}
 	}
@@ -664,6 +862,8 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting replica: %w", err)
 	}
 
+	d.Set("deletion_protection_enabled", table.DeletionProtectionEnabled)
+
 	if table.TableClassSummary != nil {
 		d.Set("table_class", table.TableClassSummary.TableClass)
 	} else {
@@ -811,6 +1011,11 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.TableClass = aws.String(d.Get("table_class").(string))
 	}
 
+	if d.HasChange("deletion_protection_enabled") {
+		hasTableUpdate = true
+		input.DeletionProtectionEnabled = aws.Bool(d.Get("deletion_protection_enabled").(bool))
+	}
+
 	if hasTableUpdate {
 		log.Printf("[DEBUG] Updating DynamoDB Table: %s", input)
 		_, err := conn.UpdateTable(input)
@@ -888,7 +1093,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("point_in_time_recovery") {
-		if err := updateDynamoDbPITR(d, conn); err != nil {
+		if err := updateDynamoDbPITR(d.Id(), d.Get("point_in_time_recovery.0.enabled").(bool), conn); err != nil {
 			return fmt.Errorf("error updating DynamoDB Table (%s) point in time recovery: %w", d.Id(), err)
 		}
 	}
@@ -1027,11 +1232,9 @@ func updateDynamoDbTimeToLive(tableName string, ttlList []interface{}, conn *dyn
 	return nil
 }
 
-func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
-	toEnable := d.Get("point_in_time_recovery.0.enabled").(bool)
-
+func updateDynamoDbPITR(tableName string, toEnable bool, conn *dynamodb.DynamoDB) error {
 	input := &dynamodb.UpdateContinuousBackupsInput{
-		TableName: aws.String(d.Id()),
+		TableName: aws.String(tableName),
 		PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
 			PointInTimeRecoveryEnabled: aws.Bool(toEnable),
 		},
@@ -1057,7 +1260,7 @@ func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
 		return fmt.Errorf("error updating DynamoDB PITR status: %w", err)
 	}
 
-	if _, err := waitDynamoDBPITRUpdated(conn, d.Id(), toEnable); err != nil {
+	if _, err := waitDynamoDBPITRUpdated(conn, tableName, toEnable); err != nil {
 		return fmt.Errorf("error waiting for DynamoDB PITR update: %w", err)
 	}
 
@@ -1596,6 +1799,68 @@ func expandDynamoDbKeySchema(data map[string]interface{}) []*dynamodb.KeySchemaE
 	return keySchema
 }
 
+func expandDynamoDbImportTable(data map[string]interface{}) *dynamodb.ImportTableInput {
+	a := &dynamodb.ImportTableInput{
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := data["input_compression_type"].(string); ok && v != "" {
+		a.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := data["input_format"].(string); ok && v != "" {
+		a.InputFormat = aws.String(v)
+	}
+
+	if v, ok := data["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.InputFormatOptions = expandDynamoDbInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := data["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.S3BucketSource = expandDynamoDbS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandDynamoDbInputFormatOptions(data map[string]interface{}) *dynamodb.InputFormatOptions {
+	a := &dynamodb.InputFormatOptions{}
+
+	if v, ok := data["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.Csv = &dynamodb.CsvOptions{}
+
+		csv := v[0].(map[string]interface{})
+
+		if s, ok := csv["delimiter"].(string); ok && s != "" {
+			a.Csv.Delimiter = aws.String(s)
+		}
+
+		if l, ok := csv["header_list"].([]interface{}); ok && len(l) > 0 {
+			a.Csv.HeaderList = flex.ExpandStringList(l)
+		}
+	}
+
+	return a
+}
+
+func expandDynamoDbS3BucketSource(data map[string]interface{}) *dynamodb.S3BucketSource {
+	a := &dynamodb.S3BucketSource{}
+
+	if v, ok := data["bucket"].(string); ok && v != "" {
+		a.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := data["bucket_owner"].(string); ok && v != "" {
+		a.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := data["key_prefix"].(string); ok && v != "" {
+		a.S3KeyPrefix = aws.String(v)
+	}
+
+	return a
+}
+
 func expandDynamoDbEncryptAtRestOptions(vOptions []interface{}) *dynamodb.SSESpecification {
 	options := &dynamodb.SSESpecification{}
 
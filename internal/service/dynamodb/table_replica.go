@@ -0,0 +1,321 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const tableReplicaIDSeparator = ":"
+
+func ResourceTableReplica() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableReplicaCreate,
+		Read:   resourceTableReplicaRead,
+		Update: resourceTableReplicaUpdate,
+		Delete: resourceTableReplicaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceTableReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	mainRegion, tableName, err := tableReplicaParseGlobalTableARN(d.Get("global_table_arn").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if mainRegion == replicaRegion {
+		return fmt.Errorf("error creating DynamoDB Table Replica (%s): replica Region (%s) must differ from the global table Region", tableName, replicaRegion)
+	}
+
+	mainConn, err := tableReplicaMainConn(meta, mainRegion)
+
+	if err != nil {
+		return err
+	}
+
+	replica := map[string]interface{}{
+		"region_name": replicaRegion,
+		"kms_key_arn": d.Get("kms_key_arn").(string),
+	}
+
+	// Replicas are created by updating the table in the global table's Region.
+	if err := createDynamoDbReplicas(tableName, []interface{}{replica}, mainConn); err != nil {
+		return err
+	}
+
+	d.SetId(TableReplicaCreateID(tableName, mainRegion))
+
+	if d.Get("point_in_time_recovery").(bool) {
+		if err := updateDynamoDbPITR(tableName, true, conn); err != nil {
+			return fmt.Errorf("error enabling DynamoDB Table Replica (%s) point in time recovery: %w", d.Id(), err)
+		}
+	}
+
+	if len(tags) > 0 {
+		table, err := FindDynamoDBTableByName(conn, tableName)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table Replica (%s): %w", d.Id(), err)
+		}
+
+		if table == nil {
+			return fmt.Errorf("error reading DynamoDB Table Replica (%s): empty response", d.Id())
+		}
+
+		if err := UpdateTags(conn, aws.StringValue(table.TableArn), nil, tags); err != nil {
+			return fmt.Errorf("error adding DynamoDB Table Replica (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mainConn, err := tableReplicaMainConn(meta, mainRegion)
+
+	if err != nil {
+		return err
+	}
+
+	mainTable, err := FindDynamoDBTableByName(mainConn, tableName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table Replica (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) in Region (%s): %w", tableName, mainRegion, err)
+	}
+
+	if mainTable == nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) in Region (%s): empty response", tableName, mainRegion)
+	}
+
+	var replica *dynamodb.ReplicaDescription
+
+	for _, v := range mainTable.Replicas {
+		if aws.StringValue(v.RegionName) == replicaRegion {
+			replica = v
+			break
+		}
+	}
+
+	if !d.IsNewResource() && replica == nil {
+		log.Printf("[WARN] DynamoDB Table Replica (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if replica == nil {
+		return fmt.Errorf("error reading DynamoDB Table Replica (%s): replica not found in Region (%s)", d.Id(), replicaRegion)
+	}
+
+	d.Set("global_table_arn", mainTable.TableArn)
+	d.Set("kms_key_arn", replica.KMSMasterKeyId)
+
+	table, err := FindDynamoDBTableByName(conn, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table Replica (%s): %w", d.Id(), err)
+	}
+
+	if table == nil {
+		return fmt.Errorf("error reading DynamoDB Table Replica (%s): empty response", d.Id())
+	}
+
+	replicaARN := aws.StringValue(table.TableArn)
+	d.Set("arn", replicaARN)
+
+	pitr, err := FindDynamoDBPITRDescriptionByTableName(conn, tableName)
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, "UnknownOperationException") {
+		return fmt.Errorf("error reading DynamoDB Table Replica (%s) point in time recovery: %w", d.Id(), err)
+	}
+
+	d.Set("point_in_time_recovery", pitr != nil && aws.StringValue(pitr.PointInTimeRecoveryStatus) == dynamodb.PointInTimeRecoveryStatusEnabled)
+
+	tags, err := ListTags(conn, replicaARN)
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, "UnknownOperationException") {
+		return fmt.Errorf("error listing tags for DynamoDB Table Replica (%s): %w", replicaARN, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTableReplicaUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, _, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("point_in_time_recovery") {
+		if err := updateDynamoDbPITR(tableName, d.Get("point_in_time_recovery").(bool), conn); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table Replica (%s) point in time recovery: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table Replica (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mainConn, err := tableReplicaMainConn(meta, mainRegion)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Replica: %s", d.Id())
+	err = deleteDynamoDbReplicas(tableName, []interface{}{map[string]interface{}{"region_name": replicaRegion}}, mainConn)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func TableReplicaCreateID(tableName, mainRegion string) string {
+	return strings.Join([]string{tableName, mainRegion}, tableReplicaIDSeparator)
+}
+
+func TableReplicaParseID(id string) (string, string, error) {
+	parts := strings.Split(id, tableReplicaIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TABLE_NAME%[2]sMAIN_REGION", id, tableReplicaIDSeparator)
+}
+
+func tableReplicaParseGlobalTableARN(v string) (string, string, error) {
+	tableARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing global table ARN (%s): %w", v, err)
+	}
+
+	tableName := strings.TrimPrefix(tableARN.Resource, "table/")
+
+	if tableName == tableARN.Resource || tableName == "" {
+		return "", "", fmt.Errorf("unexpected format for global table ARN (%s)", v)
+	}
+
+	return tableARN.Region, tableName, nil
+}
+
+// tableReplicaMainConn returns a DynamoDB connection to the global table's Region,
+// where replicas are created, described and deleted.
+func tableReplicaMainConn(meta interface{}, region string) (*dynamodb.DynamoDB, error) {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	if aws.StringValue(conn.Config.Region) == region {
+		return conn, nil
+	}
+
+	session, err := conns.NewSessionForRegion(&conn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	return dynamodb.New(session), nil
+}
@@ -1583,6 +1583,73 @@ func TestAccDynamoDBTable_tableClassInfrequentAccess(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_deletionProtection(t *testing.T) {
+	var table dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableDeletionProtectionConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableDeletionProtectionConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_importTable(t *testing.T) {
+	var table dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableImportTableConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "table_class", "STANDARD_INFREQUENT_ACCESS"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_table"},
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_backup_encryption(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2763,6 +2830,70 @@ resource "aws_dynamodb_table" "test" {
 `, rName, tableClass)
 }
 
+func testAccTableDeletionProtectionConfig(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  hash_key                    = "TestTableHashKey"
+  name                        = %[1]q
+  read_capacity               = 1
+  write_capacity              = 1
+  deletion_protection_enabled = %[2]t
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+`, rName, enabled)
+}
+
+func testAccTableImportTableConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "data/import.csv"
+  content = <<EOF
+TestTableHashKey,Value
+key1,value1
+key2,value2
+EOF
+}
+
+resource "aws_dynamodb_table" "test" {
+  hash_key     = "TestTableHashKey"
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  table_class  = "STANDARD_INFREQUENT_ACCESS"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  import_table {
+    input_format           = "CSV"
+    input_compression_type = "NONE"
+
+    s3_bucket_source {
+      bucket     = aws_s3_bucket.test.id
+      key_prefix = "data/"
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_s3_bucket_object.test]
+}
+`, rName)
+}
+
 func testAccAWSDynamoDbBackupConfigInitialStateWithOverrideEncryption(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "source" {
@@ -2,15 +2,20 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	kinesisStreamingDestinationActiveTimeout   = 5 * time.Minute
 	kinesisStreamingDestinationDisabledTimeout = 5 * time.Minute
+	contributorInsightsEnabledTimeout          = 5 * time.Minute
+	contributorInsightsDisabledTimeout         = 5 * time.Minute
 	createTableTimeout                         = 30 * time.Minute
 	updateTableTimeoutTotal                    = 60 * time.Minute
 	replicaUpdateTimeout                       = 30 * time.Minute
@@ -258,3 +263,66 @@ func waitDynamoDBSSEUpdated(conn *dynamodb.DynamoDB, tableName string) (*dynamod
 
 	return nil, err
 }
+
+func waitDynamoDBImportTableCompleted(conn *dynamodb.DynamoDB, importARN string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.ImportStatusInProgress,
+		},
+		Target: []string{
+			dynamodb.ImportStatusCompleted,
+		},
+		Timeout: timeout,
+		Refresh: statusDynamoDBImportTable(conn, importARN),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if status := aws.StringValue(output.ImportStatus); status == dynamodb.ImportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDynamoDBContributorInsightsEnabled(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusEnabling},
+		Target:  []string{dynamodb.ContributorInsightsStatusEnabled},
+		Timeout: contributorInsightsEnabledTimeout,
+		Refresh: statusDynamoDBContributorInsights(ctx, conn, tableName, indexName),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		if output.FailureException != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureException.ExceptionName), aws.StringValue(output.FailureException.ExceptionDescription)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDynamoDBContributorInsightsDisabled(ctx context.Context, conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusDisabling},
+		Target:  []string{dynamodb.ContributorInsightsStatusDisabled},
+		Timeout: contributorInsightsDisabledTimeout,
+		Refresh: statusDynamoDBContributorInsights(ctx, conn, tableName, indexName),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,313 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2CapacityReservationFleet_basic(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservation(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_totalTargetCapacity(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "prioritized"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`capacity-reservation-fleet/crf-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_match_criteria", "open"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance_type_specification.*", map[string]string{
+						"instance_platform": "Linux/UNIX",
+						"instance_type":     "t3.micro",
+						"priority":          "1",
+						"weight":            "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "default"),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_disappears(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservation(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_totalTargetCapacity(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceCapacityReservationFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_tags(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservation(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_totalTargetCapacity(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservation(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_totalTargetCapacity(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_totalTargetCapacity(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_endDate(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	endDate1 := time.Now().UTC().Add(12 * time.Hour).Format(time.RFC3339)
+	endDate2 := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservation(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_endDate(endDate1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "end_date", endDate1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_endDate(endDate2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "end_date", endDate2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationFleetExists(n string, v *ec2.CapacityReservationFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Reservation Fleet ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindCapacityReservationFleetByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityReservationFleetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_capacity_reservation_fleet" {
+			continue
+		}
+
+		_, err := tfec2.FindCapacityReservationFleetByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Capacity Reservation Fleet %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCapacityReservationFleetConfigBase() string {
+	return acctest.ConfigAvailableAZsNoOptIn()
+}
+
+func testAccCapacityReservationFleetConfig_totalTargetCapacity(totalTargetCapacity int) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfigBase(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = %[1]d
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+  }
+}
+`, totalTargetCapacity))
+}
+
+func testAccCapacityReservationFleetConfig_endDate(endDate string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfigBase(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  end_date              = %[1]q
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+  }
+}
+`, endDate))
+}
+
+func testAccCapacityReservationFleetConfig_tags1(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfigBase(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccCapacityReservationFleetConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfigBase(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
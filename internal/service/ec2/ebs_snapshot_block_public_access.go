@@ -0,0 +1,89 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceEBSSnapshotBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEBSSnapshotBlockPublicAccessPut,
+		Read:   resourceEBSSnapshotBlockPublicAccessRead,
+		Update: resourceEBSSnapshotBlockPublicAccessPut,
+		Delete: resourceEBSSnapshotBlockPublicAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ec2.SnapshotBlockPublicAccessState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceEBSSnapshotBlockPublicAccessPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	state := d.Get("state").(string)
+	if err := setEbsSnapshotBlockPublicAccessState(conn, state); err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return resourceEBSSnapshotBlockPublicAccessRead(d, meta)
+}
+
+func resourceEBSSnapshotBlockPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	state, err := FindSnapshotBlockPublicAccessState(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading EBS snapshot block public access state: %w", err)
+	}
+
+	d.Set("state", state)
+
+	return nil
+}
+
+func resourceEBSSnapshotBlockPublicAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// Removing the resource unblocks public sharing of snapshots.
+	return setEbsSnapshotBlockPublicAccessState(conn, ec2.SnapshotBlockPublicAccessStateUnblocked)
+}
+
+func setEbsSnapshotBlockPublicAccessState(conn *ec2.EC2, state string) error {
+	var err error
+
+	if state == ec2.SnapshotBlockPublicAccessStateUnblocked {
+		_, err = conn.DisableSnapshotBlockPublicAccess(&ec2.DisableSnapshotBlockPublicAccessInput{})
+	} else {
+		_, err = conn.EnableSnapshotBlockPublicAccess(&ec2.EnableSnapshotBlockPublicAccessInput{
+			State: aws.String(state),
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting EBS snapshot block public access state (%s): %w", state, err)
+	}
+
+	if err := WaitSnapshotBlockPublicAccessStateUpdated(conn, state); err != nil {
+		return fmt.Errorf("error waiting for EBS snapshot block public access state (%s): %w", state, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2EBSSnapshotBlockPublicAccess_basic(t *testing.T) {
+	resourceName := "aws_ebs_snapshot_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEBSSnapshotBlockPublicAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig("block-all-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotBlockPublicAccessState("block-all-sharing"),
+					resource.TestCheckResourceAttr(resourceName, "state", "block-all-sharing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig("block-new-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotBlockPublicAccessState("block-new-sharing"),
+					resource.TestCheckResourceAttr(resourceName, "state", "block-new-sharing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEBSSnapshotBlockPublicAccessDestroy(s *terraform.State) error {
+	return testAccCheckEBSSnapshotBlockPublicAccessState(ec2.SnapshotBlockPublicAccessStateUnblocked)(s)
+}
+
+func testAccCheckEBSSnapshotBlockPublicAccessState(expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		state, err := tfec2.FindSnapshotBlockPublicAccessState(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if state != expected {
+			return fmt.Errorf("EBS snapshot block public access state is %q, expected %q", state, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccEBSSnapshotBlockPublicAccessConfig(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_snapshot_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
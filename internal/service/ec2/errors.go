@@ -10,60 +10,67 @@ import (
 )
 
 const (
-	ErrCodeAuthFailure                               = "AuthFailure"
-	ErrCodeClientInvalidHostIDNotFound               = "Client.InvalidHostID.NotFound"
-	ErrCodeClientVpnAssociationIdNotFound            = "InvalidClientVpnAssociationId.NotFound"
-	ErrCodeClientVpnAuthorizationRuleNotFound        = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
-	ErrCodeClientVpnEndpointIdNotFound               = "InvalidClientVpnEndpointId.NotFound"
-	ErrCodeClientVpnRouteNotFound                    = "InvalidClientVpnRouteNotFound"
-	ErrCodeDependencyViolation                       = "DependencyViolation"
-	ErrCodeGatewayNotAttached                        = "Gateway.NotAttached"
-	ErrCodeIncorrectState                            = "IncorrectState"
-	ErrCodeInvalidAssociationIDNotFound              = "InvalidAssociationID.NotFound"
-	ErrCodeInvalidAttachmentIDNotFound               = "InvalidAttachmentID.NotFound"
-	ErrCodeInvalidCarrierGatewayIDNotFound           = "InvalidCarrierGatewayID.NotFound"
-	ErrCodeInvalidCustomerGatewayIDNotFound          = "InvalidCustomerGatewayID.NotFound"
-	ErrCodeInvalidDhcpOptionIDNotFound               = "InvalidDhcpOptionID.NotFound"
-	ErrCodeInvalidFlowLogIdNotFound                  = "InvalidFlowLogId.NotFound"
-	ErrCodeInvalidGatewayIDNotFound                  = "InvalidGatewayID.NotFound"
-	ErrCodeInvalidGroupNotFound                      = "InvalidGroup.NotFound"
-	ErrCodeInvalidHostIDNotFound                     = "InvalidHostID.NotFound"
-	ErrCodeInvalidInstanceIDNotFound                 = "InvalidInstanceID.NotFound"
-	ErrCodeInvalidInternetGatewayIDNotFound          = "InvalidInternetGatewayID.NotFound"
-	ErrCodeInvalidKeyPairNotFound                    = "InvalidKeyPair.NotFound"
-	ErrCodeInvalidNetworkAclIDNotFound               = "InvalidNetworkAclID.NotFound"
-	ErrCodeInvalidNetworkInterfaceIDNotFound         = "InvalidNetworkInterfaceID.NotFound"
-	ErrCodeInvalidParameter                          = "InvalidParameter"
-	ErrCodeInvalidParameterException                 = "InvalidParameterException"
-	ErrCodeInvalidParameterValue                     = "InvalidParameterValue"
-	ErrCodeInvalidPermissionDuplicate                = "InvalidPermission.Duplicate"
-	ErrCodeInvalidPermissionMalformed                = "InvalidPermission.Malformed"
-	ErrCodeInvalidPermissionNotFound                 = "InvalidPermission.NotFound"
-	ErrCodeInvalidPlacementGroupUnknown              = "InvalidPlacementGroup.Unknown"
-	ErrCodeInvalidPrefixListIDNotFound               = "InvalidPrefixListID.NotFound"
-	ErrCodeInvalidRouteNotFound                      = "InvalidRoute.NotFound"
-	ErrCodeInvalidRouteTableIDNotFound               = "InvalidRouteTableID.NotFound"
-	ErrCodeInvalidRouteTableIdNotFound               = "InvalidRouteTableId.NotFound"
-	ErrCodeInvalidSecurityGroupIDNotFound            = "InvalidSecurityGroupID.NotFound"
-	ErrCodeInvalidSnapshotInUse                      = "InvalidSnapshot.InUse"
-	ErrCodeInvalidSnapshotNotFound                   = "InvalidSnapshot.NotFound"
-	ErrCodeInvalidSpotInstanceRequestIDNotFound      = "InvalidSpotInstanceRequestID.NotFound"
-	ErrCodeInvalidSubnetCidrReservationIDNotFound    = "InvalidSubnetCidrReservationID.NotFound"
-	ErrCodeInvalidSubnetIDNotFound                   = "InvalidSubnetID.NotFound"
-	ErrCodeInvalidSubnetIdNotFound                   = "InvalidSubnetId.NotFound"
-	ErrCodeInvalidTransitGatewayAttachmentIDNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
-	ErrCodeInvalidTransitGatewayIDNotFound           = "InvalidTransitGatewayID.NotFound"
-	ErrCodeInvalidVpcCidrBlockAssociationIDNotFound  = "InvalidVpcCidrBlockAssociationID.NotFound"
-	ErrCodeInvalidVpcEndpointIdNotFound              = "InvalidVpcEndpointId.NotFound"
-	ErrCodeInvalidVpcEndpointNotFound                = "InvalidVpcEndpoint.NotFound"
-	ErrCodeInvalidVpcEndpointServiceIdNotFound       = "InvalidVpcEndpointServiceId.NotFound"
-	ErrCodeInvalidVpcIDNotFound                      = "InvalidVpcID.NotFound"
-	ErrCodeInvalidVpcPeeringConnectionIDNotFound     = "InvalidVpcPeeringConnectionID.NotFound"
-	ErrCodeInvalidVpnConnectionIDNotFound            = "InvalidVpnConnectionID.NotFound"
-	ErrCodeInvalidVpnGatewayAttachmentNotFound       = "InvalidVpnGatewayAttachment.NotFound"
-	ErrCodeInvalidVpnGatewayIDNotFound               = "InvalidVpnGatewayID.NotFound"
-	ErrCodeNatGatewayNotFound                        = "NatGatewayNotFound"
-	ErrCodeUnsupportedOperation                      = "UnsupportedOperation"
+	ErrCodeAuthFailure                                = "AuthFailure"
+	ErrCodeClientInvalidHostIDNotFound                = "Client.InvalidHostID.NotFound"
+	ErrCodeClientVpnAssociationIdNotFound             = "InvalidClientVpnAssociationId.NotFound"
+	ErrCodeClientVpnAuthorizationRuleNotFound         = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
+	ErrCodeClientVpnEndpointIdNotFound                = "InvalidClientVpnEndpointId.NotFound"
+	ErrCodeClientVpnRouteNotFound                     = "InvalidClientVpnRouteNotFound"
+	ErrCodeDependencyViolation                        = "DependencyViolation"
+	ErrCodeGatewayNotAttached                         = "Gateway.NotAttached"
+	ErrCodeIncorrectState                             = "IncorrectState"
+	ErrCodeInvalidAssociationIDNotFound               = "InvalidAssociationID.NotFound"
+	ErrCodeInvalidAttachmentIDNotFound                = "InvalidAttachmentID.NotFound"
+	ErrCodeInvalidCapacityReservationFleetIdNotFound  = "InvalidCapacityReservationFleetId.NotFound"
+	ErrCodeInvalidCarrierGatewayIDNotFound            = "InvalidCarrierGatewayID.NotFound"
+	ErrCodeInvalidCustomerGatewayIDNotFound           = "InvalidCustomerGatewayID.NotFound"
+	ErrCodeInvalidDhcpOptionIDNotFound                = "InvalidDhcpOptionID.NotFound"
+	ErrCodeInvalidFlowLogIdNotFound                   = "InvalidFlowLogId.NotFound"
+	ErrCodeInvalidGatewayIDNotFound                   = "InvalidGatewayID.NotFound"
+	ErrCodeInvalidGroupNotFound                       = "InvalidGroup.NotFound"
+	ErrCodeInvalidHostIDNotFound                      = "InvalidHostID.NotFound"
+	ErrCodeInvalidInstanceIDNotFound                  = "InvalidInstanceID.NotFound"
+	ErrCodeInvalidInstanceConnectEndpointIdNotFound   = "InvalidInstanceConnectEndpointId.NotFound"
+	ErrCodeInvalidInternetGatewayIDNotFound           = "InvalidInternetGatewayID.NotFound"
+	ErrCodeInvalidKeyPairNotFound                     = "InvalidKeyPair.NotFound"
+	ErrCodeInvalidNetworkAclIDNotFound                = "InvalidNetworkAclID.NotFound"
+	ErrCodeInvalidNetworkInsightsAnalysisIdNotFound   = "InvalidNetworkInsightsAnalysisId.NotFound"
+	ErrCodeInvalidNetworkInsightsPathIdNotFound       = "InvalidNetworkInsightsPathId.NotFound"
+	ErrCodeInvalidNetworkInterfaceIDNotFound          = "InvalidNetworkInterfaceID.NotFound"
+	ErrCodeInvalidParameter                           = "InvalidParameter"
+	ErrCodeInvalidParameterException                  = "InvalidParameterException"
+	ErrCodeInvalidParameterValue                      = "InvalidParameterValue"
+	ErrCodeInvalidPermissionDuplicate                 = "InvalidPermission.Duplicate"
+	ErrCodeInvalidPermissionMalformed                 = "InvalidPermission.Malformed"
+	ErrCodeInvalidPermissionNotFound                  = "InvalidPermission.NotFound"
+	ErrCodeInvalidPlacementGroupUnknown               = "InvalidPlacementGroup.Unknown"
+	ErrCodeInvalidPrefixListIDNotFound                = "InvalidPrefixListID.NotFound"
+	ErrCodeInvalidRouteNotFound                       = "InvalidRoute.NotFound"
+	ErrCodeInvalidRouteTableIDNotFound                = "InvalidRouteTableID.NotFound"
+	ErrCodeInvalidRouteTableIdNotFound                = "InvalidRouteTableId.NotFound"
+	ErrCodeInvalidSecurityGroupIDNotFound             = "InvalidSecurityGroupID.NotFound"
+	ErrCodeInvalidSecurityGroupRuleIdNotFound         = "InvalidSecurityGroupRuleId.NotFound"
+	ErrCodeInvalidSnapshotInUse                       = "InvalidSnapshot.InUse"
+	ErrCodeInvalidSnapshotNotFound                    = "InvalidSnapshot.NotFound"
+	ErrCodeInvalidSpotInstanceRequestIDNotFound       = "InvalidSpotInstanceRequestID.NotFound"
+	ErrCodeInvalidSubnetCidrReservationIDNotFound     = "InvalidSubnetCidrReservationID.NotFound"
+	ErrCodeInvalidSubnetIDNotFound                    = "InvalidSubnetID.NotFound"
+	ErrCodeInvalidSubnetIdNotFound                    = "InvalidSubnetId.NotFound"
+	ErrCodeInvalidTransitGatewayAttachmentIDNotFound  = "InvalidTransitGatewayAttachmentID.NotFound"
+	ErrCodeInvalidTransitGatewayConnectPeerIDNotFound = "InvalidTransitGatewayConnectPeerID.NotFound"
+	ErrCodeInvalidTransitGatewayIDNotFound            = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayPolicyTableIdNotFound = "InvalidTransitGatewayPolicyTableId.NotFound"
+	ErrCodeInvalidVpcCidrBlockAssociationIDNotFound   = "InvalidVpcCidrBlockAssociationID.NotFound"
+	ErrCodeInvalidVpcEndpointIdNotFound               = "InvalidVpcEndpointId.NotFound"
+	ErrCodeInvalidVpcEndpointNotFound                 = "InvalidVpcEndpoint.NotFound"
+	ErrCodeInvalidVpcEndpointServiceIdNotFound        = "InvalidVpcEndpointServiceId.NotFound"
+	ErrCodeInvalidVpcIDNotFound                       = "InvalidVpcID.NotFound"
+	ErrCodeInvalidVpcPeeringConnectionIDNotFound      = "InvalidVpcPeeringConnectionID.NotFound"
+	ErrCodeInvalidVpnConnectionIDNotFound             = "InvalidVpnConnectionID.NotFound"
+	ErrCodeInvalidVpnGatewayAttachmentNotFound        = "InvalidVpnGatewayAttachment.NotFound"
+	ErrCodeInvalidVpnGatewayIDNotFound                = "InvalidVpnGatewayID.NotFound"
+	ErrCodeNatGatewayNotFound                         = "NatGatewayNotFound"
+	ErrCodeUnsupportedOperation                       = "UnsupportedOperation"
 )
 
 func UnsuccessfulItemError(apiObject *ec2.UnsuccessfulItemError) error {
@@ -91,3 +98,49 @@ func UnsuccessfulItemsError(apiObjects []*ec2.UnsuccessfulItem) error {
 
 	return errors.ErrorOrNil()
 }
+
+func CancelCapacityReservationFleetError(apiObject *ec2.CancelCapacityReservationFleetError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Code), aws.StringValue(apiObject.Message), nil)
+}
+
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		err := CancelCapacityReservationFleetError(apiObject.CancelCapacityReservationFleetError)
+
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func CreateFleetError(apiObject *ec2.CreateFleetError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage), nil)
+}
+
+func CreateFleetErrors(apiObjects []*ec2.CreateFleetError) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CreateFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,7 +29,13 @@ func ResourceFleet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Instant fleets can't be modified.
+			customdiff.ForceNewIf("excess_capacity_termination_policy", fleetIsInstant),
+			customdiff.ForceNewIf("launch_template_config", fleetIsInstant),
+			customdiff.ForceNewIf("target_capacity_specification.0.total_target_capacity", fleetIsInstant),
+			verify.SetTagsDiff,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -44,6 +52,43 @@ func ResourceFleet() *schema.Resource {
 					ec2.FleetExcessCapacityTerminationPolicyTermination,
 				}, false),
 			},
+			"fleet_instance_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"fleet_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"fulfilled_on_demand_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 			"launch_template_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -83,6 +128,12 @@ func ResourceFleet() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
+									"instance_requirements": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     instanceRequirementsSchema(""),
+									},
 									"instance_type": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -159,10 +210,13 @@ func ResourceFleet() *schema.Resource {
 							ForceNew: true,
 							Default:  "lowestPrice",
 							ValidateFunc: validation.StringInSlice([]string{
+								ec2.SpotAllocationStrategyCapacityOptimized,
+								ec2.SpotAllocationStrategyCapacityOptimizedPrioritized,
 								ec2.SpotAllocationStrategyDiversified,
 								// AWS SDK constant incorrect
 								// ec2.SpotAllocationStrategyLowestPrice,
 								"lowestPrice",
+								ec2.SpotAllocationStrategyPriceCapacityOptimized,
 							}, false),
 						},
 						"instance_interruption_behavior": {
@@ -208,12 +262,16 @@ func ResourceFleet() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"replacement_strategy": {
-													Type:     schema.TypeString,
-													Optional: true,
-													ForceNew: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														"launch",
-													}, false),
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(ec2.FleetReplacementStrategy_Values(), false),
+												},
+												"termination_delay": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(120, 7200),
 												},
 											},
 										},
@@ -316,14 +374,11 @@ func ResourceFleet() *schema.Resource {
 				ForceNew: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  ec2.FleetTypeMaintain,
-				ValidateFunc: validation.StringInSlice([]string{
-					ec2.FleetTypeMaintain,
-					ec2.FleetTypeRequest,
-				}, false),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetTypeMaintain,
+				ValidateFunc: validation.StringInSlice(ec2.FleetType_Values(), false),
 			},
 		},
 	}
@@ -334,8 +389,8 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	fleetType := d.Get("type").(string)
 	input := &ec2.CreateFleetInput{
-		ExcessCapacityTerminationPolicy:  aws.String(d.Get("excess_capacity_termination_policy").(string)),
 		LaunchTemplateConfigs:            expandEc2FleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{})),
 		OnDemandOptions:                  expandEc2OnDemandOptionsRequest(d.Get("on_demand_options").([]interface{})),
 		ReplaceUnhealthyInstances:        aws.Bool(d.Get("replace_unhealthy_instances").(bool)),
@@ -343,10 +398,15 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 		TagSpecifications:                ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeFleet),
 		TargetCapacitySpecification:      expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
 		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
-		Type:                             aws.String(d.Get("type").(string)),
+		Type:                             aws.String(fleetType),
+	}
+
+	// InvalidParameterCombination: The excess capacity termination policy is not supported for instant fleets.
+	if fleetType != ec2.FleetTypeInstant {
+		input.ExcessCapacityTerminationPolicy = aws.String(d.Get("excess_capacity_termination_policy").(string))
 	}
 
-	if d.Get("type").(string) != ec2.FleetTypeMaintain {
+	if fleetType != ec2.FleetTypeMaintain {
 		if input.SpotOptions.MaintenanceStrategies != nil {
 			log.Printf("[WARN] EC2 Fleet (%s) has an invalid configuration and can not be created. Capacity Rebalance maintenance strategies can only be specified for fleets of type maintain.", input)
 			return nil
@@ -362,10 +422,17 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(output.FleetId))
 
-	// If a request type is fulfilled immediately, we can miss the transition from active to deleted
+	// Instant fleets report launch failures synchronously.
+	if fleetType == ec2.FleetTypeInstant && len(output.Instances) == 0 {
+		if err := CreateFleetErrors(output.Errors); err != nil {
+			return fmt.Errorf("error creating EC2 Fleet (%s): %w", d.Id(), err)
+		}
+	}
+
+	// If a request or instant type is fulfilled immediately, we can miss the transition from active to deleted
 	// Instead of an error here, allow the Read function to trigger recreation
 	target := []string{ec2.FleetStateCodeActive}
-	if d.Get("type").(string) == ec2.FleetTypeRequest {
+	if fleetType == ec2.FleetTypeRequest || fleetType == ec2.FleetTypeInstant {
 		target = append(target, ec2.FleetStateCodeDeleted)
 		target = append(target, ec2.FleetStateCodeDeletedRunning)
 		target = append(target, ec2.FleetStateCodeDeletedTerminating)
@@ -452,7 +519,17 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
+	if fleet.ExcessCapacityTerminationPolicy != nil {
+		d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
+	}
+
+	if err := d.Set("fleet_instance_set", flattenFleetInstances(fleet.Instances)); err != nil {
+		return fmt.Errorf("error setting fleet_instance_set: %w", err)
+	}
+
+	d.Set("fleet_state", fleet.FleetState)
+	d.Set("fulfilled_capacity", fleet.FulfilledCapacity)
+	d.Set("fulfilled_on_demand_capacity", fleet.FulfilledOnDemandCapacity)
 
 	if err := d.Set("launch_template_config", flattenEc2FleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
 		return fmt.Errorf("error setting launch_template_config: %s", err)
@@ -492,6 +569,25 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	// Instant fleets can't be modified, changes to them either force replacement or are local-only.
+	if d.Get("type").(string) != ec2.FleetTypeInstant {
+		if err := modifyFleet(d, conn); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourceFleetRead(d, meta)
+}
+
+func modifyFleet(d *schema.ResourceData, conn *ec2.EC2) error {
 	input := &ec2.ModifyFleetInput{
 		ExcessCapacityTerminationPolicy: aws.String(d.Get("excess_capacity_termination_policy").(string)),
 		LaunchTemplateConfigs:           expandEc2FleetLaunchTemplateConfigRequests(d.Get("launch_template_config").([]interface{})),
@@ -523,23 +619,18 @@ func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for EC2 Fleet (%s) modification: %s", d.Id(), err)
 	}
 
-	if d.HasChange("tags_all") {
-		o, n := d.GetChange("tags_all")
-
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
-		}
-	}
-
-	return resourceFleetRead(d, meta)
+	return nil
 }
 
 func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	// Instances launched by an instant fleet must be terminated when the fleet is deleted.
+	terminateInstances := d.Get("terminate_instances").(bool) || d.Get("type").(string) == ec2.FleetTypeInstant
+
 	input := &ec2.DeleteFleetsInput{
 		FleetIds:           []*string{aws.String(d.Id())},
-		TerminateInstances: aws.Bool(d.Get("terminate_instances").(bool)),
+		TerminateInstances: aws.Bool(terminateInstances),
 	}
 
 	log.Printf("[DEBUG] Deleting EC2 Fleet (%s): %s", d.Id(), input)
@@ -555,7 +646,7 @@ func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 
 	pending := []string{ec2.FleetStateCodeActive}
 	target := []string{ec2.FleetStateCodeDeleted}
-	if d.Get("terminate_instances").(bool) {
+	if terminateInstances {
 		pending = append(pending, ec2.FleetStateCodeDeletedTerminating)
 		// AWS SDK constant is incorrect: unexpected state 'deleted_terminating', wanted target 'deleted, deleted-terminating'
 		pending = append(pending, "deleted_terminating")
@@ -621,6 +712,10 @@ func ec2FleetRefreshFunc(conn *ec2.EC2, fleetID string) resource.StateRefreshFun
 	}
 }
 
+func fleetIsInstant(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.Id() != "" && d.Get("type").(string) == ec2.FleetTypeInstant
+}
+
 func expandEc2FleetLaunchTemplateConfigRequests(l []interface{}) []*ec2.FleetLaunchTemplateConfigRequest {
 	fleetLaunchTemplateConfigRequests := make([]*ec2.FleetLaunchTemplateConfigRequest, len(l))
 	for i, m := range l {
@@ -670,6 +765,10 @@ func expandEc2FleetLaunchTemplateOverridesRequest(m map[string]interface{}) *ec2
 		fleetLaunchTemplateOverridesRequest.AvailabilityZone = aws.String(v.(string))
 	}
 
+	if v, ok := m["instance_requirements"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fleetLaunchTemplateOverridesRequest.InstanceRequirements = expandInstanceRequirementsRequest(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := m["instance_type"]; ok && v.(string) != "" {
 		fleetLaunchTemplateOverridesRequest.InstanceType = aws.String(v.(string))
 	}
@@ -777,6 +876,10 @@ func expandFleetSpotCapacityRebalanceRequest(l []interface{}) *ec2.FleetSpotCapa
 		capacityRebalance.ReplacementStrategy = aws.String(v.(string))
 	}
 
+	if v, ok := m["termination_delay"]; ok && v.(int) != 0 {
+		capacityRebalance.TerminationDelay = aws.Int64(int64(v.(int)))
+	}
+
 	return capacityRebalance
 }
 
@@ -833,12 +936,13 @@ func flattenEc2FleetLaunchTemplateOverrides(fleetLaunchTemplateOverrides []*ec2.
 			continue
 		}
 		m := map[string]interface{}{
-			"availability_zone": aws.StringValue(fleetLaunchTemplateOverride.AvailabilityZone),
-			"instance_type":     aws.StringValue(fleetLaunchTemplateOverride.InstanceType),
-			"max_price":         aws.StringValue(fleetLaunchTemplateOverride.MaxPrice),
-			"priority":          aws.Float64Value(fleetLaunchTemplateOverride.Priority),
-			"subnet_id":         aws.StringValue(fleetLaunchTemplateOverride.SubnetId),
-			"weighted_capacity": aws.Float64Value(fleetLaunchTemplateOverride.WeightedCapacity),
+			"availability_zone":     aws.StringValue(fleetLaunchTemplateOverride.AvailabilityZone),
+			"instance_requirements": flattenInstanceRequirements(fleetLaunchTemplateOverride.InstanceRequirements),
+			"instance_type":         aws.StringValue(fleetLaunchTemplateOverride.InstanceType),
+			"max_price":             aws.StringValue(fleetLaunchTemplateOverride.MaxPrice),
+			"priority":              aws.Float64Value(fleetLaunchTemplateOverride.Priority),
+			"subnet_id":             aws.StringValue(fleetLaunchTemplateOverride.SubnetId),
+			"weighted_capacity":     aws.Float64Value(fleetLaunchTemplateOverride.WeightedCapacity),
 		}
 		l[i] = m
 	}
@@ -912,6 +1016,7 @@ func flattenFleetSpotCapacityRebalance(fleetSpotCapacityRebalance *ec2.FleetSpot
 
 	m := map[string]interface{}{
 		"replacement_strategy": aws.StringValue(fleetSpotCapacityRebalance.ReplacementStrategy),
+		"termination_delay":    aws.Int64Value(fleetSpotCapacityRebalance.TerminationDelay),
 	}
 
 	return []interface{}{m}
@@ -931,3 +1036,26 @@ func flattenEc2TargetCapacitySpecification(targetCapacitySpecification *ec2.Targ
 
 	return []interface{}{m}
 }
+
+func flattenFleetInstances(apiObjects []*ec2.DescribeFleetsInstances) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"instance_ids":  aws.StringValueSlice(apiObject.InstanceIds),
+			"instance_type": aws.StringValue(apiObject.InstanceType),
+			"lifecycle":     aws.StringValue(apiObject.Lifecycle),
+			"platform":      aws.StringValue(apiObject.Platform),
+		})
+	}
+
+	return tfList
+}
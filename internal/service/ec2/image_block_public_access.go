@@ -0,0 +1,89 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImageBlockPublicAccessPut,
+		Read:   resourceImageBlockPublicAccessRead,
+		Update: resourceImageBlockPublicAccessPut,
+		Delete: resourceImageBlockPublicAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(append(ec2.ImageBlockPublicAccessDisabledState_Values(), ec2.ImageBlockPublicAccessEnabledState_Values()...), false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(conn, state); err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return resourceImageBlockPublicAccessRead(d, meta)
+}
+
+func resourceImageBlockPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	state, err := FindImageBlockPublicAccessState(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 image block public access state: %w", err)
+	}
+
+	d.Set("state", state)
+
+	return nil
+}
+
+func resourceImageBlockPublicAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// Removing the resource unblocks public sharing of AMIs.
+	return setImageBlockPublicAccessState(conn, ec2.ImageBlockPublicAccessDisabledStateUnblocked)
+}
+
+func setImageBlockPublicAccessState(conn *ec2.EC2, state string) error {
+	var err error
+
+	if state == ec2.ImageBlockPublicAccessDisabledStateUnblocked {
+		_, err = conn.DisableImageBlockPublicAccess(&ec2.DisableImageBlockPublicAccessInput{})
+	} else {
+		_, err = conn.EnableImageBlockPublicAccess(&ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: aws.String(state),
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting EC2 image block public access state (%s): %w", state, err)
+	}
+
+	if err := WaitImageBlockPublicAccessStateUpdated(conn, state); err != nil {
+		return fmt.Errorf("error waiting for EC2 image block public access state (%s): %w", state, err)
+	}
+
+	return nil
+}
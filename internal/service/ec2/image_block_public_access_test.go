@@ -0,0 +1,75 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2ImageBlockPublicAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_image_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckImageBlockPublicAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBlockPublicAccessConfig("block-new-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccessState("block-new-sharing"),
+					resource.TestCheckResourceAttr(resourceName, "state", "block-new-sharing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImageBlockPublicAccessConfig("unblocked"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccessState("unblocked"),
+					resource.TestCheckResourceAttr(resourceName, "state", "unblocked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageBlockPublicAccessDestroy(s *terraform.State) error {
+	return testAccCheckImageBlockPublicAccessState(ec2.ImageBlockPublicAccessDisabledStateUnblocked)(s)
+}
+
+func testAccCheckImageBlockPublicAccessState(expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		state, err := tfec2.FindImageBlockPublicAccessState(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if state != expected {
+			return fmt.Errorf("EC2 image block public access state is %q, expected %q", state, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccImageBlockPublicAccessConfig(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_image_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
@@ -0,0 +1,201 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInstanceConnectEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceConnectEndpointCreate,
+		Read:   resourceInstanceConnectEndpointRead,
+		Update: resourceInstanceConnectEndpointUpdate,
+		Delete: resourceInstanceConnectEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fips_dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preserve_client_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInstanceConnectEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateInstanceConnectEndpointInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		PreserveClientIp:  aws.Bool(d.Get("preserve_client_ip").(bool)),
+		SubnetId:          aws.String(d.Get("subnet_id").(string)),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstanceConnectEndpoint),
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Instance Connect Endpoint: %s", input)
+	output, err := conn.CreateInstanceConnectEndpoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Instance Connect Endpoint: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.InstanceConnectEndpoint.InstanceConnectEndpointId))
+
+	if _, err := WaitInstanceConnectEndpointCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance Connect Endpoint (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceInstanceConnectEndpointRead(d, meta)
+}
+
+func resourceInstanceConnectEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	endpoint, err := FindInstanceConnectEndpointByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance Connect Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance Connect Endpoint (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", endpoint.InstanceConnectEndpointArn)
+	d.Set("availability_zone", endpoint.AvailabilityZone)
+	d.Set("dns_name", endpoint.DnsName)
+	d.Set("fips_dns_name", endpoint.FipsDnsName)
+	d.Set("network_interface_ids", aws.StringValueSlice(endpoint.NetworkInterfaceIds))
+	d.Set("owner_id", endpoint.OwnerId)
+	d.Set("preserve_client_ip", endpoint.PreserveClientIp)
+	d.Set("security_group_ids", aws.StringValueSlice(endpoint.SecurityGroupIds))
+	d.Set("subnet_id", endpoint.SubnetId)
+	d.Set("vpc_id", endpoint.VpcId)
+
+	tags := KeyValueTags(endpoint.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceInstanceConnectEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Instance Connect Endpoint (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceInstanceConnectEndpointRead(d, meta)
+}
+
+func resourceInstanceConnectEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting EC2 Instance Connect Endpoint: %s", d.Id())
+	_, err := conn.DeleteInstanceConnectEndpoint(&ec2.DeleteInstanceConnectEndpointInput{
+		InstanceConnectEndpointId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidInstanceConnectEndpointIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Instance Connect Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitInstanceConnectEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance Connect Endpoint (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
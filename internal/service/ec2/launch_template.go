@@ -347,9 +347,18 @@ func ResourceLaunchTemplate() *schema.Resource {
 				},
 			},
 
+			"instance_requirements": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"instance_type"},
+				Elem:          instanceRequirementsSchema("instance_requirements.0."),
+			},
+
 			"instance_type": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"instance_requirements"},
 			},
 
 			"kernel_id": {
@@ -376,6 +385,21 @@ func ResourceLaunchTemplate() *schema.Resource {
 				},
 			},
 
+			"maintenance_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_recovery": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(ec2.LaunchTemplateAutoRecoveryState_Values(), false),
+						},
+					},
+				},
+			},
+
 			"metadata_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -584,6 +608,29 @@ func ResourceLaunchTemplate() *schema.Resource {
 				},
 			},
 
+			"private_dns_name_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_resource_name_dns_aaaa_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable_resource_name_dns_a_record": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"hostname_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(ec2.HostnameType_Values(), false),
+						},
+					},
+				},
+			},
+
 			"ram_disk_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -836,10 +883,18 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting instance_market_options: %s", err)
 	}
 
+	if err := d.Set("instance_requirements", flattenInstanceRequirements(ltData.InstanceRequirements)); err != nil {
+		return fmt.Errorf("error setting instance_requirements: %s", err)
+	}
+
 	if err := d.Set("license_specification", getLicenseSpecifications(ltData.LicenseSpecifications)); err != nil {
 		return fmt.Errorf("error setting license_specification: %s", err)
 	}
 
+	if err := d.Set("maintenance_options", flattenLaunchTemplateInstanceMaintenanceOptions(ltData.MaintenanceOptions)); err != nil {
+		return fmt.Errorf("error setting maintenance_options: %s", err)
+	}
+
 	if err := d.Set("metadata_options", flattenLaunchTemplateInstanceMetadataOptions(ltData.MetadataOptions)); err != nil {
 		return fmt.Errorf("error setting metadata_options: %s", err)
 	}
@@ -860,6 +915,10 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting placement: %s", err)
 	}
 
+	if err := d.Set("private_dns_name_options", flattenLaunchTemplatePrivateDNSNameOptions(ltData.PrivateDnsNameOptions)); err != nil {
+		return fmt.Errorf("error setting private_dns_name_options: %s", err)
+	}
+
 	if err := d.Set("hibernation_options", flattenLaunchTemplateHibernationOptions(ltData.HibernationOptions)); err != nil {
 		return fmt.Errorf("error setting hibernation_options: %s", err)
 	}
@@ -1306,6 +1365,584 @@ func flattenLaunchTemplateHibernationOptions(m *ec2.LaunchTemplateHibernationOpt
 	return s
 }
 
+func expandLaunchTemplateInstanceMaintenanceOptions(l []interface{}) *ec2.LaunchTemplateInstanceMaintenanceOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	opts := &ec2.LaunchTemplateInstanceMaintenanceOptionsRequest{}
+
+	if v, ok := m["auto_recovery"].(string); ok && v != "" {
+		opts.AutoRecovery = aws.String(v)
+	}
+
+	return opts
+}
+
+func flattenLaunchTemplateInstanceMaintenanceOptions(opts *ec2.LaunchTemplateInstanceMaintenanceOptions) []interface{} {
+	if opts == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"auto_recovery": aws.StringValue(opts.AutoRecovery),
+	}
+
+	return []interface{}{m}
+}
+
+func expandLaunchTemplatePrivateDNSNameOptions(l []interface{}) *ec2.LaunchTemplatePrivateDnsNameOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	opts := &ec2.LaunchTemplatePrivateDnsNameOptionsRequest{
+		EnableResourceNameDnsAAAARecord: aws.Bool(m["enable_resource_name_dns_aaaa_record"].(bool)),
+		EnableResourceNameDnsARecord:    aws.Bool(m["enable_resource_name_dns_a_record"].(bool)),
+	}
+
+	if v, ok := m["hostname_type"].(string); ok && v != "" {
+		opts.HostnameType = aws.String(v)
+	}
+
+	return opts
+}
+
+func flattenLaunchTemplatePrivateDNSNameOptions(opts *ec2.LaunchTemplatePrivateDnsNameOptions) []interface{} {
+	if opts == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"enable_resource_name_dns_aaaa_record": aws.BoolValue(opts.EnableResourceNameDnsAAAARecord),
+		"enable_resource_name_dns_a_record":    aws.BoolValue(opts.EnableResourceNameDnsARecord),
+		"hostname_type":                        aws.StringValue(opts.HostnameType),
+	}
+
+	return []interface{}{m}
+}
+
+// instanceRequirementsSchema returns the schema for attribute-based instance type selection.
+// pathPrefix is the absolute path to the block (e.g. "instance_requirements.0.") and is used
+// for conflicting attribute validation. An empty pathPrefix skips that validation, which is
+// required when the block is nested inside a list of arbitrary length.
+func instanceRequirementsSchema(pathPrefix string) *schema.Resource {
+	conflictsWith := func(k string) []string {
+		if pathPrefix == "" {
+			return nil
+		}
+
+		return []string{pathPrefix + k}
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"accelerator_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"accelerator_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
+				},
+			},
+			"accelerator_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
+				},
+			},
+			"accelerator_total_memory_mib": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"accelerator_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
+				},
+			},
+			"allowed_instance_types": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      400,
+				ConflictsWith: conflictsWith("excluded_instance_types"),
+				Elem:          &schema.Schema{Type: schema.TypeString},
+			},
+			"bare_metal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
+			},
+			"baseline_ebs_bandwidth_mbps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"burstable_performance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
+			},
+			"cpu_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
+				},
+			},
+			"excluded_instance_types": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      400,
+				ConflictsWith: conflictsWith("allowed_instance_types"),
+				Elem:          &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_generations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
+				},
+			},
+			"local_storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
+			},
+			"local_storage_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
+				},
+			},
+			"memory_gib_per_vcpu": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.5),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.5),
+						},
+					},
+				},
+			},
+			"memory_mib": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"network_bandwidth_gbps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+					},
+				},
+			},
+			"network_interface_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"on_demand_max_price_percentage_over_lowest_price": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"require_hibernate_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"spot_max_price_percentage_over_lowest_price": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"total_local_storage_gb": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+					},
+				},
+			},
+			"vcpu_count": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandInstanceRequirementsRequest(tfMap map[string]interface{}) *ec2.InstanceRequirementsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.InstanceRequirementsRequest{}
+
+	if v, ok := tfMap["accelerator_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AcceleratorCount = &ec2.AcceleratorCountRequest{}
+
+		min := tfMap["min"].(int)
+		apiObject.AcceleratorCount.Min = aws.Int64(int64(min))
+
+		// A maximum of 0 excludes instance types with accelerators.
+		if v := tfMap["max"].(int); v >= min {
+			apiObject.AcceleratorCount.Max = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["accelerator_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorManufacturers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["accelerator_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["accelerator_total_memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsIntRange(v[0].(map[string]interface{}))
+		apiObject.AcceleratorTotalMemoryMiB = &ec2.AcceleratorTotalMemoryMiBRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["bare_metal"].(string); ok && v != "" {
+		apiObject.BareMetal = aws.String(v)
+	}
+
+	if v, ok := tfMap["baseline_ebs_bandwidth_mbps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsIntRange(v[0].(map[string]interface{}))
+		apiObject.BaselineEbsBandwidthMbps = &ec2.BaselineEbsBandwidthMbpsRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["burstable_performance"].(string); ok && v != "" {
+		apiObject.BurstablePerformance = aws.String(v)
+	}
+
+	if v, ok := tfMap["cpu_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CpuManufacturers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["instance_generations"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InstanceGenerations = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["local_storage"].(string); ok && v != "" {
+		apiObject.LocalStorage = aws.String(v)
+	}
+
+	if v, ok := tfMap["local_storage_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LocalStorageTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsFloatRange(v[0].(map[string]interface{}))
+		apiObject.MemoryGiBPerVCpu = &ec2.MemoryGiBPerVCpuRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsIntRange(v[0].(map[string]interface{}))
+		apiObject.MemoryMiB = &ec2.MemoryMiBRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["network_bandwidth_gbps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsFloatRange(v[0].(map[string]interface{}))
+		apiObject.NetworkBandwidthGbps = &ec2.NetworkBandwidthGbpsRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsIntRange(v[0].(map[string]interface{}))
+		apiObject.NetworkInterfaceCount = &ec2.NetworkInterfaceCountRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["on_demand_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
+		apiObject.OnDemandMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["require_hibernate_support"].(bool); ok && v {
+		apiObject.RequireHibernateSupport = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["spot_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
+		apiObject.SpotMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["total_local_storage_gb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsFloatRange(v[0].(map[string]interface{}))
+		apiObject.TotalLocalStorageGB = &ec2.TotalLocalStorageGBRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["vcpu_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInstanceRequirementsIntRange(v[0].(map[string]interface{}))
+		apiObject.VCpuCount = &ec2.VCpuCountRangeRequest{Max: max, Min: min}
+	}
+
+	return apiObject
+}
+
+func expandInstanceRequirementsIntRange(tfMap map[string]interface{}) (*int64, *int64) {
+	var min, max *int64
+
+	if v, ok := tfMap["min"].(int); ok && v != 0 {
+		min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && v != 0 {
+		max = aws.Int64(int64(v))
+	}
+
+	return min, max
+}
+
+func expandInstanceRequirementsFloatRange(tfMap map[string]interface{}) (*float64, *float64) {
+	var min, max *float64
+
+	if v, ok := tfMap["min"].(float64); ok && v != 0 {
+		min = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max"].(float64); ok && v != 0 {
+		max = aws.Float64(v)
+	}
+
+	return min, max
+}
+
+func flattenInstanceRequirements(apiObject *ec2.InstanceRequirements) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"accelerator_manufacturers":                        aws.StringValueSlice(apiObject.AcceleratorManufacturers),
+		"accelerator_names":                                aws.StringValueSlice(apiObject.AcceleratorNames),
+		"accelerator_types":                                aws.StringValueSlice(apiObject.AcceleratorTypes),
+		"allowed_instance_types":                           aws.StringValueSlice(apiObject.AllowedInstanceTypes),
+		"bare_metal":                                       aws.StringValue(apiObject.BareMetal),
+		"burstable_performance":                            aws.StringValue(apiObject.BurstablePerformance),
+		"cpu_manufacturers":                                aws.StringValueSlice(apiObject.CpuManufacturers),
+		"excluded_instance_types":                          aws.StringValueSlice(apiObject.ExcludedInstanceTypes),
+		"instance_generations":                             aws.StringValueSlice(apiObject.InstanceGenerations),
+		"local_storage":                                    aws.StringValue(apiObject.LocalStorage),
+		"local_storage_types":                              aws.StringValueSlice(apiObject.LocalStorageTypes),
+		"on_demand_max_price_percentage_over_lowest_price": aws.Int64Value(apiObject.OnDemandMaxPricePercentageOverLowestPrice),
+		"require_hibernate_support":                        aws.BoolValue(apiObject.RequireHibernateSupport),
+		"spot_max_price_percentage_over_lowest_price":      aws.Int64Value(apiObject.SpotMaxPricePercentageOverLowestPrice),
+	}
+
+	if v := apiObject.AcceleratorCount; v != nil {
+		tfMap["accelerator_count"] = flattenInstanceRequirementsIntRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.AcceleratorTotalMemoryMiB; v != nil {
+		tfMap["accelerator_total_memory_mib"] = flattenInstanceRequirementsIntRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.BaselineEbsBandwidthMbps; v != nil {
+		tfMap["baseline_ebs_bandwidth_mbps"] = flattenInstanceRequirementsIntRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.MemoryGiBPerVCpu; v != nil {
+		tfMap["memory_gib_per_vcpu"] = flattenInstanceRequirementsFloatRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.MemoryMiB; v != nil {
+		tfMap["memory_mib"] = flattenInstanceRequirementsIntRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.NetworkBandwidthGbps; v != nil {
+		tfMap["network_bandwidth_gbps"] = flattenInstanceRequirementsFloatRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.NetworkInterfaceCount; v != nil {
+		tfMap["network_interface_count"] = flattenInstanceRequirementsIntRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.TotalLocalStorageGB; v != nil {
+		tfMap["total_local_storage_gb"] = flattenInstanceRequirementsFloatRange(v.Min, v.Max)
+	}
+
+	if v := apiObject.VCpuCount; v != nil {
+		tfMap["vcpu_count"] = flattenInstanceRequirementsIntRange(v.Min, v.Max)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInstanceRequirementsIntRange(min, max *int64) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if min != nil {
+		tfMap["min"] = aws.Int64Value(min)
+	}
+
+	if max != nil {
+		tfMap["max"] = aws.Int64Value(max)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInstanceRequirementsFloatRange(min, max *float64) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if min != nil {
+		tfMap["min"] = aws.Float64Value(min)
+	}
+
+	if max != nil {
+		tfMap["max"] = aws.Float64Value(max)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func getTagSpecifications(t []*ec2.LaunchTemplateTagSpecification) []interface{} {
 	var s []interface{}
 	for _, v := range t {
@@ -1442,6 +2079,10 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 		}
 	}
 
+	if v, ok := d.GetOk("instance_requirements"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		opts.InstanceRequirements = expandInstanceRequirementsRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("license_specification"); ok {
 		var licenseSpecifications []*ec2.LaunchTemplateLicenseConfigurationRequest
 		lsList := v.(*schema.Set).List()
@@ -1455,6 +2096,10 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 		opts.LicenseSpecifications = licenseSpecifications
 	}
 
+	if v, ok := d.GetOk("maintenance_options"); ok {
+		opts.MaintenanceOptions = expandLaunchTemplateInstanceMaintenanceOptions(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("metadata_options"); ok {
 		opts.MetadataOptions = expandLaunchTemplateInstanceMetadataOptions(v.([]interface{}))
 	}
@@ -1507,6 +2152,10 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 		}
 	}
 
+	if v, ok := d.GetOk("private_dns_name_options"); ok {
+		opts.PrivateDnsNameOptions = expandLaunchTemplatePrivateDNSNameOptions(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("hibernation_options"); ok {
 		opts.HibernationOptions = expandLaunchTemplateHibernationOptions(v.([]interface{}))
 	}
@@ -1911,14 +2560,17 @@ var updateKeys = []string{
 	"image_id",
 	"instance_initiated_shutdown_behavior",
 	"instance_market_options",
+	"instance_requirements",
 	"instance_type",
 	"kernel_id",
 	"key_name",
 	"license_specification",
+	"maintenance_options",
 	"metadata_options",
 	"monitoring",
 	"network_interfaces",
 	"placement",
+	"private_dns_name_options",
 	"ram_disk_id",
 	"security_group_names",
 	"tag_specifications",
@@ -201,6 +201,222 @@ func DataSourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
+			"instance_requirements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accelerator_count": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"accelerator_manufacturers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"accelerator_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"accelerator_total_memory_mib": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"accelerator_types": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_instance_types": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"bare_metal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"baseline_ebs_bandwidth_mbps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"burstable_performance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cpu_manufacturers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"excluded_instance_types": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"instance_generations": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"local_storage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_storage_types": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"memory_gib_per_vcpu": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"memory_mib": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"network_bandwidth_gbps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"network_interface_count": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"on_demand_max_price_percentage_over_lowest_price": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"require_hibernate_support": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"spot_max_price_percentage_over_lowest_price": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_local_storage_gb": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"vcpu_count": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"instance_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -213,6 +429,18 @@ func DataSourceLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"maintenance_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_recovery": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"metadata_options": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -376,6 +604,26 @@ func DataSourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
+			"private_dns_name_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_resource_name_dns_aaaa_record": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_resource_name_dns_a_record": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"hostname_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"ram_disk_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -545,6 +793,14 @@ func dataSourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting instance_market_options: %w", err)
 	}
 
+	if err := d.Set("instance_requirements", flattenInstanceRequirements(ltData.InstanceRequirements)); err != nil {
+		return fmt.Errorf("error setting instance_requirements: %w", err)
+	}
+
+	if err := d.Set("maintenance_options", flattenLaunchTemplateInstanceMaintenanceOptions(ltData.MaintenanceOptions)); err != nil {
+		return fmt.Errorf("error setting maintenance_options: %w", err)
+	}
+
 	if err := d.Set("metadata_options", flattenLaunchTemplateInstanceMetadataOptions(ltData.MetadataOptions)); err != nil {
 		return fmt.Errorf("error setting metadata_options: %w", err)
 	}
@@ -565,6 +821,10 @@ func dataSourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting placement: %w", err)
 	}
 
+	if err := d.Set("private_dns_name_options", flattenLaunchTemplatePrivateDNSNameOptions(ltData.PrivateDnsNameOptions)); err != nil {
+		return fmt.Errorf("error setting private_dns_name_options: %w", err)
+	}
+
 	if err := d.Set("hibernation_options", flattenLaunchTemplateHibernationOptions(ltData.HibernationOptions)); err != nil {
 		return fmt.Errorf("error setting hibernation_options: %w", err)
 	}
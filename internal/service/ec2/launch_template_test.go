@@ -1234,6 +1234,129 @@ func TestAccEC2LaunchTemplate_enclaveOptions(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_instanceRequirements(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_instanceRequirements(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.accelerator_count.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.accelerator_count.0.max", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.burstable_performance", ec2.BurstablePerformanceExcluded),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.cpu_manufacturers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "instance_requirements.0.cpu_manufacturers.*", ec2.CpuManufacturerAmd),
+					resource.TestCheckTypeSetElemAttr(resourceName, "instance_requirements.0.cpu_manufacturers.*", ec2.CpuManufacturerIntel),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.excluded_instance_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "instance_requirements.0.excluded_instance_types.*", "t2.*"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.instance_generations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "instance_requirements.0.instance_generations.*", ec2.InstanceGenerationCurrent),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.memory_gib_per_vcpu.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.memory_gib_per_vcpu.0.max", "8"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.memory_gib_per_vcpu.0.min", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.memory_mib.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.memory_mib.0.max", "16384"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.memory_mib.0.min", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.vcpu_count.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.vcpu_count.0.max", "8"),
+					resource.TestCheckResourceAttr(resourceName, "instance_requirements.0.vcpu_count.0.min", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_maintenanceOptions(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_maintenanceOptions(rName, ec2.LaunchTemplateAutoRecoveryStateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_options.0.auto_recovery", ec2.LaunchTemplateAutoRecoveryStateDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchTemplateConfig_maintenanceOptions(rName, ec2.LaunchTemplateAutoRecoveryStateDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_options.0.auto_recovery", ec2.LaunchTemplateAutoRecoveryStateDefault),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_privateDNSNameOptions(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_privateDNSNameOptions(rName, true, false, ec2.HostnameTypeResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.enable_resource_name_dns_aaaa_record", "true"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.enable_resource_name_dns_a_record", "false"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.hostname_type", ec2.HostnameTypeResourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchTemplateConfig_privateDNSNameOptions(rName, false, true, ec2.HostnameTypeIpName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.enable_resource_name_dns_aaaa_record", "false"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.enable_resource_name_dns_a_record", "true"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_options.0.hostname_type", ec2.HostnameTypeIpName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_hibernation(t *testing.T) {
 	var template ec2.LaunchTemplate
 	resourceName := "aws_launch_template.test"
@@ -2314,6 +2437,66 @@ resource "aws_launch_template" "test" {
 `, rName, enabled)
 }
 
+func testAccLaunchTemplateConfig_instanceRequirements(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  instance_requirements {
+    accelerator_count {
+      max = 0
+    }
+
+    burstable_performance   = "excluded"
+    cpu_manufacturers       = ["amd", "intel"]
+    excluded_instance_types = ["t2.*"]
+    instance_generations    = ["current"]
+
+    memory_gib_per_vcpu {
+      min = 0.5
+      max = 8
+    }
+
+    memory_mib {
+      min = 1024
+      max = 16384
+    }
+
+    vcpu_count {
+      min = 2
+      max = 8
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLaunchTemplateConfig_maintenanceOptions(rName, autoRecovery string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  maintenance_options {
+    auto_recovery = %[2]q
+  }
+}
+`, rName, autoRecovery)
+}
+
+func testAccLaunchTemplateConfig_privateDNSNameOptions(rName string, enableAAAA, enableA bool, hostnameType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  private_dns_name_options {
+    enable_resource_name_dns_aaaa_record = %[2]t
+    enable_resource_name_dns_a_record    = %[3]t
+    hostname_type                        = %[4]q
+  }
+}
+`, rName, enableAAAA, enableA, hostnameType)
+}
+
 func testAccLaunchTemplateHibernationConfig(rName string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -0,0 +1,704 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkInsightsAnalysis() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkInsightsAnalysisCreate,
+		Read:   resourceNetworkInsightsAnalysisRead,
+		Update: resourceNetworkInsightsAnalysisUpdate,
+		Delete: resourceNetworkInsightsAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_completion", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alternate_path_hints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"explanations": networkInsightsAnalysisExplanationsSchema(),
+			"filter_in_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"forward_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"network_insights_path_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path_found": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"return_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNetworkInsightsAnalysisCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.StartNetworkInsightsAnalysisInput{
+		ClientToken:           aws.String(resource.UniqueId()),
+		NetworkInsightsPathId: aws.String(d.Get("network_insights_path_id").(string)),
+		TagSpecifications:     ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsAnalysis),
+	}
+
+	if v, ok := d.GetOk("filter_in_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FilterInArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Starting EC2 Network Insights Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAnalysis(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting EC2 Network Insights Analysis: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := WaitNetworkInsightsAnalysisCreated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 Network Insights Analysis (%s) create: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindNetworkInsightsAnalysisByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("alternate_path_hints", flattenAlternatePathHints(output.AlternatePathHints)); err != nil {
+		return fmt.Errorf("error setting alternate_path_hints: %w", err)
+	}
+	d.Set("arn", output.NetworkInsightsAnalysisArn)
+	if err := d.Set("explanations", flattenExplanations(output.Explanations)); err != nil {
+		return fmt.Errorf("error setting explanations: %w", err)
+	}
+	d.Set("filter_in_arns", aws.StringValueSlice(output.FilterInArns))
+	if err := d.Set("forward_path_components", flattenPathComponents(output.ForwardPathComponents)); err != nil {
+		return fmt.Errorf("error setting forward_path_components: %w", err)
+	}
+	d.Set("network_insights_path_id", output.NetworkInsightsPathId)
+	d.Set("path_found", output.NetworkPathFound)
+	if err := d.Set("return_path_components", flattenPathComponents(output.ReturnPathComponents)); err != nil {
+		return fmt.Errorf("error setting return_path_components: %w", err)
+	}
+	if output.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkInsightsAnalysisUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Network Insights Analysis (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(d, meta)
+}
+
+func resourceNetworkInsightsAnalysisDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting EC2 Network Insights Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAnalysis(&ec2.DeleteNetworkInsightsAnalysisInput{
+		NetworkInsightsAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidNetworkInsightsAnalysisIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Network Insights Analysis (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func networkInsightsAnalysisComputedString() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+func networkInsightsAnalysisComputedInt() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+}
+
+func networkInsightsAnalysisComputedStringSet() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func networkInsightsAnalysisComputedList(m map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: m,
+		},
+	}
+}
+
+func networkInsightsAnalysisComponentSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"arn":  networkInsightsAnalysisComputedString(),
+		"id":   networkInsightsAnalysisComputedString(),
+		"name": networkInsightsAnalysisComputedString(),
+	})
+}
+
+func networkInsightsAnalysisPortRangeSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"from": networkInsightsAnalysisComputedInt(),
+		"to":   networkInsightsAnalysisComputedInt(),
+	})
+}
+
+func networkInsightsAnalysisAclRuleSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"cidr": networkInsightsAnalysisComputedString(),
+		"egress": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"port_range":  networkInsightsAnalysisPortRangeSchema(),
+		"protocol":    networkInsightsAnalysisComputedString(),
+		"rule_action": networkInsightsAnalysisComputedString(),
+		"rule_number": networkInsightsAnalysisComputedInt(),
+	})
+}
+
+func networkInsightsAnalysisPacketHeaderSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"destination_addresses":   networkInsightsAnalysisComputedStringSet(),
+		"destination_port_ranges": networkInsightsAnalysisPortRangeSchema(),
+		"protocol":                networkInsightsAnalysisComputedString(),
+		"source_addresses":        networkInsightsAnalysisComputedStringSet(),
+		"source_port_ranges":      networkInsightsAnalysisPortRangeSchema(),
+	})
+}
+
+func networkInsightsAnalysisRouteTableRouteSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"destination_cidr":                networkInsightsAnalysisComputedString(),
+		"destination_prefix_list_id":      networkInsightsAnalysisComputedString(),
+		"egress_only_internet_gateway_id": networkInsightsAnalysisComputedString(),
+		"gateway_id":                      networkInsightsAnalysisComputedString(),
+		"instance_id":                     networkInsightsAnalysisComputedString(),
+		"nat_gateway_id":                  networkInsightsAnalysisComputedString(),
+		"network_interface_id":            networkInsightsAnalysisComputedString(),
+		"origin":                          networkInsightsAnalysisComputedString(),
+		"transit_gateway_id":              networkInsightsAnalysisComputedString(),
+		"vpc_peering_connection_id":       networkInsightsAnalysisComputedString(),
+	})
+}
+
+func networkInsightsAnalysisSecurityGroupRuleSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"cidr":              networkInsightsAnalysisComputedString(),
+		"direction":         networkInsightsAnalysisComputedString(),
+		"port_range":        networkInsightsAnalysisPortRangeSchema(),
+		"prefix_list_id":    networkInsightsAnalysisComputedString(),
+		"protocol":          networkInsightsAnalysisComputedString(),
+		"security_group_id": networkInsightsAnalysisComputedString(),
+	})
+}
+
+func networkInsightsAnalysisTransitGatewayRouteTableRouteSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"attachment_id":    networkInsightsAnalysisComputedString(),
+		"destination_cidr": networkInsightsAnalysisComputedString(),
+		"prefix_list_id":   networkInsightsAnalysisComputedString(),
+		"resource_id":      networkInsightsAnalysisComputedString(),
+		"resource_type":    networkInsightsAnalysisComputedString(),
+		"route_origin":     networkInsightsAnalysisComputedString(),
+		"state":            networkInsightsAnalysisComputedString(),
+	})
+}
+
+func networkInsightsAnalysisExplanationsSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"acl":                networkInsightsAnalysisComponentSchema(),
+		"acl_rule":           networkInsightsAnalysisAclRuleSchema(),
+		"address":            networkInsightsAnalysisComputedString(),
+		"addresses":          networkInsightsAnalysisComputedStringSet(),
+		"attached_to":        networkInsightsAnalysisComponentSchema(),
+		"availability_zones": networkInsightsAnalysisComputedStringSet(),
+		"cidrs":              networkInsightsAnalysisComputedStringSet(),
+		"classic_load_balancer_listener": networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+			"instance_port":      networkInsightsAnalysisComputedInt(),
+			"load_balancer_port": networkInsightsAnalysisComputedInt(),
+		}),
+		"component":                      networkInsightsAnalysisComponentSchema(),
+		"customer_gateway":               networkInsightsAnalysisComponentSchema(),
+		"destination":                    networkInsightsAnalysisComponentSchema(),
+		"destination_vpc":                networkInsightsAnalysisComponentSchema(),
+		"direction":                      networkInsightsAnalysisComputedString(),
+		"elastic_load_balancer_listener": networkInsightsAnalysisComponentSchema(),
+		"explanation_code":               networkInsightsAnalysisComputedString(),
+		"ingress_route_table":            networkInsightsAnalysisComponentSchema(),
+		"internet_gateway":               networkInsightsAnalysisComponentSchema(),
+		"load_balancer_arn":              networkInsightsAnalysisComputedString(),
+		"load_balancer_listener_port":    networkInsightsAnalysisComputedInt(),
+		"load_balancer_target": networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+			"address":           networkInsightsAnalysisComputedString(),
+			"availability_zone": networkInsightsAnalysisComputedString(),
+			"instance":          networkInsightsAnalysisComponentSchema(),
+			"port":              networkInsightsAnalysisComputedInt(),
+		}),
+		"load_balancer_target_group":        networkInsightsAnalysisComponentSchema(),
+		"load_balancer_target_groups":       networkInsightsAnalysisComponentSchema(),
+		"load_balancer_target_port":         networkInsightsAnalysisComputedInt(),
+		"missing_component":                 networkInsightsAnalysisComputedString(),
+		"nat_gateway":                       networkInsightsAnalysisComponentSchema(),
+		"network_interface":                 networkInsightsAnalysisComponentSchema(),
+		"packet_field":                      networkInsightsAnalysisComputedString(),
+		"port":                              networkInsightsAnalysisComputedInt(),
+		"port_ranges":                       networkInsightsAnalysisPortRangeSchema(),
+		"prefix_list":                       networkInsightsAnalysisComponentSchema(),
+		"protocols":                         networkInsightsAnalysisComputedStringSet(),
+		"route_table":                       networkInsightsAnalysisComponentSchema(),
+		"route_table_route":                 networkInsightsAnalysisRouteTableRouteSchema(),
+		"security_group":                    networkInsightsAnalysisComponentSchema(),
+		"security_group_rule":               networkInsightsAnalysisSecurityGroupRuleSchema(),
+		"security_groups":                   networkInsightsAnalysisComponentSchema(),
+		"source_vpc":                        networkInsightsAnalysisComponentSchema(),
+		"state":                             networkInsightsAnalysisComputedString(),
+		"subnet":                            networkInsightsAnalysisComponentSchema(),
+		"subnet_route_table":                networkInsightsAnalysisComponentSchema(),
+		"transit_gateway":                   networkInsightsAnalysisComponentSchema(),
+		"transit_gateway_attachment":        networkInsightsAnalysisComponentSchema(),
+		"transit_gateway_route_table":       networkInsightsAnalysisComponentSchema(),
+		"transit_gateway_route_table_route": networkInsightsAnalysisTransitGatewayRouteTableRouteSchema(),
+		"vpc":                               networkInsightsAnalysisComponentSchema(),
+		"vpc_endpoint":                      networkInsightsAnalysisComponentSchema(),
+		"vpc_peering_connection":            networkInsightsAnalysisComponentSchema(),
+		"vpn_connection":                    networkInsightsAnalysisComponentSchema(),
+		"vpn_gateway":                       networkInsightsAnalysisComponentSchema(),
+	})
+}
+
+func networkInsightsAnalysisPathComponentsSchema() *schema.Schema {
+	return networkInsightsAnalysisComputedList(map[string]*schema.Schema{
+		"acl_rule":                          networkInsightsAnalysisAclRuleSchema(),
+		"component":                         networkInsightsAnalysisComponentSchema(),
+		"destination_vpc":                   networkInsightsAnalysisComponentSchema(),
+		"inbound_header":                    networkInsightsAnalysisPacketHeaderSchema(),
+		"outbound_header":                   networkInsightsAnalysisPacketHeaderSchema(),
+		"route_table_route":                 networkInsightsAnalysisRouteTableRouteSchema(),
+		"security_group_rule":               networkInsightsAnalysisSecurityGroupRuleSchema(),
+		"sequence_number":                   networkInsightsAnalysisComputedInt(),
+		"source_vpc":                        networkInsightsAnalysisComponentSchema(),
+		"subnet":                            networkInsightsAnalysisComponentSchema(),
+		"transit_gateway":                   networkInsightsAnalysisComponentSchema(),
+		"transit_gateway_route_table_route": networkInsightsAnalysisTransitGatewayRouteTableRouteSchema(),
+		"vpc":                               networkInsightsAnalysisComponentSchema(),
+	})
+}
+
+func flattenAlternatePathHints(apiObjects []*ec2.AlternatePathHint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"component_arn": aws.StringValue(apiObject.ComponentArn),
+			"component_id":  aws.StringValue(apiObject.ComponentId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisAclRule(apiObject *ec2.AnalysisAclRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cidr":        aws.StringValue(apiObject.Cidr),
+		"egress":      aws.BoolValue(apiObject.Egress),
+		"port_range":  flattenPortRanges([]*ec2.PortRange{apiObject.PortRange}),
+		"protocol":    aws.StringValue(apiObject.Protocol),
+		"rule_action": aws.StringValue(apiObject.RuleAction),
+		"rule_number": aws.Int64Value(apiObject.RuleNumber),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisComponent(apiObject *ec2.AnalysisComponent) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return flattenAnalysisComponents([]*ec2.AnalysisComponent{apiObject})
+}
+
+func flattenAnalysisComponents(apiObjects []*ec2.AnalysisComponent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":  aws.StringValue(apiObject.Arn),
+			"id":   aws.StringValue(apiObject.Id),
+			"name": aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisLoadBalancerListener(apiObject *ec2.AnalysisLoadBalancerListener) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"instance_port":      aws.Int64Value(apiObject.InstancePort),
+		"load_balancer_port": aws.Int64Value(apiObject.LoadBalancerPort),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisLoadBalancerTarget(apiObject *ec2.AnalysisLoadBalancerTarget) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"address":           aws.StringValue(apiObject.Address),
+		"availability_zone": aws.StringValue(apiObject.AvailabilityZone),
+		"instance":          flattenAnalysisComponent(apiObject.Instance),
+		"port":              aws.Int64Value(apiObject.Port),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisPacketHeader(apiObject *ec2.AnalysisPacketHeader) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination_addresses":   aws.StringValueSlice(apiObject.DestinationAddresses),
+		"destination_port_ranges": flattenPortRanges(apiObject.DestinationPortRanges),
+		"protocol":                aws.StringValue(apiObject.Protocol),
+		"source_addresses":        aws.StringValueSlice(apiObject.SourceAddresses),
+		"source_port_ranges":      flattenPortRanges(apiObject.SourcePortRanges),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisRouteTableRoute(apiObject *ec2.AnalysisRouteTableRoute) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination_cidr":                aws.StringValue(apiObject.DestinationCidr),
+		"destination_prefix_list_id":      aws.StringValue(apiObject.DestinationPrefixListId),
+		"egress_only_internet_gateway_id": aws.StringValue(apiObject.EgressOnlyInternetGatewayId),
+		"gateway_id":                      aws.StringValue(apiObject.GatewayId),
+		"instance_id":                     aws.StringValue(apiObject.InstanceId),
+		"nat_gateway_id":                  aws.StringValue(apiObject.NatGatewayId),
+		"network_interface_id":            aws.StringValue(apiObject.NetworkInterfaceId),
+		"origin":                          aws.StringValue(apiObject.Origin),
+		"transit_gateway_id":              aws.StringValue(apiObject.TransitGatewayId),
+		"vpc_peering_connection_id":       aws.StringValue(apiObject.VpcPeeringConnectionId),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisSecurityGroupRule(apiObject *ec2.AnalysisSecurityGroupRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cidr":              aws.StringValue(apiObject.Cidr),
+		"direction":         aws.StringValue(apiObject.Direction),
+		"port_range":        flattenPortRanges([]*ec2.PortRange{apiObject.PortRange}),
+		"prefix_list_id":    aws.StringValue(apiObject.PrefixListId),
+		"protocol":          aws.StringValue(apiObject.Protocol),
+		"security_group_id": aws.StringValue(apiObject.SecurityGroupId),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExplanations(apiObjects []*ec2.Explanation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"acl":                               flattenAnalysisComponent(apiObject.Acl),
+			"acl_rule":                          flattenAnalysisAclRule(apiObject.AclRule),
+			"address":                           aws.StringValue(apiObject.Address),
+			"addresses":                         aws.StringValueSlice(apiObject.Addresses),
+			"attached_to":                       flattenAnalysisComponent(apiObject.AttachedTo),
+			"availability_zones":                aws.StringValueSlice(apiObject.AvailabilityZones),
+			"cidrs":                             aws.StringValueSlice(apiObject.Cidrs),
+			"classic_load_balancer_listener":    flattenAnalysisLoadBalancerListener(apiObject.ClassicLoadBalancerListener),
+			"component":                         flattenAnalysisComponent(apiObject.Component),
+			"customer_gateway":                  flattenAnalysisComponent(apiObject.CustomerGateway),
+			"destination":                       flattenAnalysisComponent(apiObject.Destination),
+			"destination_vpc":                   flattenAnalysisComponent(apiObject.DestinationVpc),
+			"direction":                         aws.StringValue(apiObject.Direction),
+			"elastic_load_balancer_listener":    flattenAnalysisComponent(apiObject.ElasticLoadBalancerListener),
+			"explanation_code":                  aws.StringValue(apiObject.ExplanationCode),
+			"ingress_route_table":               flattenAnalysisComponent(apiObject.IngressRouteTable),
+			"internet_gateway":                  flattenAnalysisComponent(apiObject.InternetGateway),
+			"load_balancer_arn":                 aws.StringValue(apiObject.LoadBalancerArn),
+			"load_balancer_listener_port":       aws.Int64Value(apiObject.LoadBalancerListenerPort),
+			"load_balancer_target":              flattenAnalysisLoadBalancerTarget(apiObject.LoadBalancerTarget),
+			"load_balancer_target_group":        flattenAnalysisComponent(apiObject.LoadBalancerTargetGroup),
+			"load_balancer_target_groups":       flattenAnalysisComponents(apiObject.LoadBalancerTargetGroups),
+			"load_balancer_target_port":         aws.Int64Value(apiObject.LoadBalancerTargetPort),
+			"missing_component":                 aws.StringValue(apiObject.MissingComponent),
+			"nat_gateway":                       flattenAnalysisComponent(apiObject.NatGateway),
+			"network_interface":                 flattenAnalysisComponent(apiObject.NetworkInterface),
+			"packet_field":                      aws.StringValue(apiObject.PacketField),
+			"port":                              aws.Int64Value(apiObject.Port),
+			"port_ranges":                       flattenPortRanges(apiObject.PortRanges),
+			"prefix_list":                       flattenAnalysisComponent(apiObject.PrefixList),
+			"protocols":                         aws.StringValueSlice(apiObject.Protocols),
+			"route_table":                       flattenAnalysisComponent(apiObject.RouteTable),
+			"route_table_route":                 flattenAnalysisRouteTableRoute(apiObject.RouteTableRoute),
+			"security_group":                    flattenAnalysisComponent(apiObject.SecurityGroup),
+			"security_group_rule":               flattenAnalysisSecurityGroupRule(apiObject.SecurityGroupRule),
+			"security_groups":                   flattenAnalysisComponents(apiObject.SecurityGroups),
+			"source_vpc":                        flattenAnalysisComponent(apiObject.SourceVpc),
+			"state":                             aws.StringValue(apiObject.State),
+			"subnet":                            flattenAnalysisComponent(apiObject.Subnet),
+			"subnet_route_table":                flattenAnalysisComponent(apiObject.SubnetRouteTable),
+			"transit_gateway":                   flattenAnalysisComponent(apiObject.TransitGateway),
+			"transit_gateway_attachment":        flattenAnalysisComponent(apiObject.TransitGatewayAttachment),
+			"transit_gateway_route_table":       flattenAnalysisComponent(apiObject.TransitGatewayRouteTable),
+			"transit_gateway_route_table_route": flattenTransitGatewayRouteTableRoute(apiObject.TransitGatewayRouteTableRoute),
+			"vpc":                               flattenAnalysisComponent(apiObject.Vpc),
+			"vpc_endpoint":                      flattenAnalysisComponent(apiObject.VpcEndpoint),
+			"vpc_peering_connection":            flattenAnalysisComponent(apiObject.VpcPeeringConnection),
+			"vpn_connection":                    flattenAnalysisComponent(apiObject.VpnConnection),
+			"vpn_gateway":                       flattenAnalysisComponent(apiObject.VpnGateway),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPathComponents(apiObjects []*ec2.PathComponent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"acl_rule":                          flattenAnalysisAclRule(apiObject.AclRule),
+			"component":                         flattenAnalysisComponent(apiObject.Component),
+			"destination_vpc":                   flattenAnalysisComponent(apiObject.DestinationVpc),
+			"inbound_header":                    flattenAnalysisPacketHeader(apiObject.InboundHeader),
+			"outbound_header":                   flattenAnalysisPacketHeader(apiObject.OutboundHeader),
+			"route_table_route":                 flattenAnalysisRouteTableRoute(apiObject.RouteTableRoute),
+			"security_group_rule":               flattenAnalysisSecurityGroupRule(apiObject.SecurityGroupRule),
+			"sequence_number":                   aws.Int64Value(apiObject.SequenceNumber),
+			"source_vpc":                        flattenAnalysisComponent(apiObject.SourceVpc),
+			"subnet":                            flattenAnalysisComponent(apiObject.Subnet),
+			"transit_gateway":                   flattenAnalysisComponent(apiObject.TransitGateway),
+			"transit_gateway_route_table_route": flattenTransitGatewayRouteTableRoute(apiObject.TransitGatewayRouteTableRoute),
+			"vpc":                               flattenAnalysisComponent(apiObject.Vpc),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPortRanges(apiObjects []*ec2.PortRange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"from": aws.Int64Value(apiObject.From),
+			"to":   aws.Int64Value(apiObject.To),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTransitGatewayRouteTableRoute(apiObject *ec2.TransitGatewayRouteTableRoute) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"attachment_id":    aws.StringValue(apiObject.AttachmentId),
+		"destination_cidr": aws.StringValue(apiObject.DestinationCidr),
+		"prefix_list_id":   aws.StringValue(apiObject.PrefixListId),
+		"resource_id":      aws.StringValue(apiObject.ResourceId),
+		"resource_type":    aws.StringValue(apiObject.ResourceType),
+		"route_origin":     aws.StringValue(apiObject.RouteOrigin),
+		"state":            aws.StringValue(apiObject.State),
+	}
+
+	return []interface{}{tfMap}
+}
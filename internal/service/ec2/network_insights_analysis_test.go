@@ -0,0 +1,251 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2NetworkInsightsAnalysis_basic(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	pathResourceName := "aws_ec2_network_insights_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`network-insights-analysis/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "filter_in_arns.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_insights_path_id", pathResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "path_found", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "forward_path_components.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "return_path_components.#"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", "succeeded"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_disappears(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceNetworkInsightsAnalysis(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_tags(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkInsightsAnalysis_waitForCompletion(t *testing.T) {
+	var v ec2.NetworkInsightsAnalysis
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInsightsAnalysisConfigWaitForCompletion(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkInsightsAnalysisConfigWaitForCompletion(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAnalysisDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_network_insights_analysis" {
+			continue
+		}
+
+		_, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Network Insights Analysis %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkInsightsAnalysisExists(n string, v *ec2.NetworkInsightsAnalysis) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Analysis ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNetworkInsightsAnalysisConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsPathConfigBase(rName), `
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+}
+`)
+}
+
+func testAccNetworkInsightsAnalysisConfig(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), `
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+}
+`)
+}
+
+func testAccNetworkInsightsAnalysisConfigWaitForCompletion(rName string, waitForCompletion bool) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  wait_for_completion      = %[1]t
+}
+`, waitForCompletion))
+}
+
+func testAccNetworkInsightsAnalysisConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccNetworkInsightsAnalysisConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccNetworkInsightsAnalysisConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -107,6 +108,15 @@ func ResourceTransitGateway() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -144,6 +154,10 @@ func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("transit_gateway_cidr_blocks"); ok && v.(*schema.Set).Len() > 0 {
+		input.Options.TransitGatewayCidrBlocks = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Transit Gateway: %s", input)
 	output, err := conn.CreateTransitGateway(input)
 	if err != nil {
@@ -202,6 +216,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("dns_support", transitGateway.Options.DnsSupport)
 	d.Set("owner_id", transitGateway.OwnerId)
 	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
+	d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks))
 
 	tags := KeyValueTags(transitGateway.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -252,6 +267,21 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 		options.DnsSupport = aws.String(d.Get("dns_support").(string))
 	}
 
+	if d.HasChange("transit_gateway_cidr_blocks") {
+		transitGatewayModified = true
+
+		o, n := d.GetChange("transit_gateway_cidr_blocks")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			options.AddTransitGatewayCidrBlocks = flex.ExpandStringSet(add)
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			options.RemoveTransitGatewayCidrBlocks = flex.ExpandStringSet(del)
+		}
+	}
+
 	if d.HasChange("vpn_ecmp_support") {
 		transitGatewayModified = true
 		options.VpnEcmpSupport = aws.String(d.Get("vpn_ecmp_support").(string))
@@ -64,6 +64,11 @@ func DataSourceTransitGateway() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -126,6 +131,7 @@ func dataSourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
+	d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks))
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	d.SetId(aws.StringValue(transitGateway.TransitGatewayId))
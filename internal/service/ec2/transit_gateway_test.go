@@ -29,8 +29,21 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 			"Description":                                        testAccTransitGateway_Description,
 			"DnsSupport":                                         testAccTransitGateway_DNSSupport,
 			"Tags":                                               testAccTransitGateway_Tags,
+			"TransitGatewayCidrBlocks":                           testAccTransitGateway_TransitGatewayCIDRBlocks,
 			"VpnEcmpSupport":                                     testAccTransitGateway_VPNECMPSupport,
 		},
+		"Connect": {
+			"basic":      testAccTransitGatewayConnect_basic,
+			"disappears": testAccTransitGatewayConnect_disappears,
+			"Tags":       testAccTransitGatewayConnect_Tags,
+			"TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled": testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled,
+		},
+		"ConnectPeer": {
+			"basic":      testAccTransitGatewayConnectPeer_basic,
+			"disappears": testAccTransitGatewayConnectPeer_disappears,
+			"BgpAsn":     testAccTransitGatewayConnectPeer_BgpAsn,
+			"Tags":       testAccTransitGatewayConnectPeer_Tags,
+		},
 		"PeeringAttachment": {
 			"basic":            testAccTransitGatewayPeeringAttachment_basic,
 			"disappears":       testAccTransitGatewayPeeringAttachment_disappears,
@@ -42,6 +55,11 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 			"TagsSameAccount":       testAccTransitGatewayPeeringAttachmentAccepter_Tags_sameAccount,
 			"basicDifferentAccount": testAccTransitGatewayPeeringAttachmentAccepter_basic_differentAccount,
 		},
+		"PolicyTable": {
+			"basic":      testAccTransitGatewayPolicyTable_basic,
+			"disappears": testAccTransitGatewayPolicyTable_disappears,
+			"Tags":       testAccTransitGatewayPolicyTable_Tags,
+		},
 		"PrefixListReference": {
 			"basic":                      testAccTransitGatewayPrefixListReference_basic,
 			"disappears":                 testAccTransitGatewayPrefixListReference_disappears,
@@ -440,6 +458,43 @@ func testAccTransitGateway_Description(t *testing.T) {
 	})
 }
 
+func testAccTransitGateway_TransitGatewayCIDRBlocks(t *testing.T) {
+	var transitGateway1, transitGateway2 ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayTransitGatewayCIDRBlocks1Config("10.120.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(resourceName, &transitGateway1),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.120.0.0/24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayTransitGatewayCIDRBlocks2Config("10.120.0.0/24", "10.121.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(resourceName, &transitGateway2),
+					testAccCheckTransitGatewayNotRecreated(&transitGateway1, &transitGateway2),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.120.0.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "transit_gateway_cidr_blocks.*", "10.121.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGateway_Tags(t *testing.T) {
 	var transitGateway1, transitGateway2, transitGateway3 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -738,6 +793,22 @@ resource "aws_ec2_transit_gateway" "test" {
 `, description)
 }
 
+func testAccTransitGatewayTransitGatewayCIDRBlocks1Config(cidrBlock1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = [%[1]q]
+}
+`, cidrBlock1)
+}
+
+func testAccTransitGatewayTransitGatewayCIDRBlocks2Config(cidrBlock1, cidrBlock2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = [%[1]q, %[2]q]
+}
+`, cidrBlock1, cidrBlock2)
+}
+
 func testAccTransitGatewayTags1Config(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -0,0 +1,367 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	securityGroupRuleTypeEgress  = "egress"
+	securityGroupRuleTypeIngress = "ingress"
+)
+
+func ResourceVPCSecurityGroupIngressRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCSecurityGroupIngressRuleCreate,
+		Read:   resourceVPCSecurityGroupIngressRuleRead,
+		Update: resourceVPCSecurityGroupIngressRuleUpdate,
+		Delete: resourceVPCSecurityGroupIngressRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: vpcSecurityGroupRuleSchema(),
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCSecurityGroupIngressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	input := &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:           aws.String(securityGroupID),
+		IpPermissions:     []*ec2.IpPermission{expandVPCSecurityGroupRuleIPPermission(d)},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
+	}
+
+	log.Printf("[DEBUG] Creating VPC Security Group Ingress Rule: %s", input)
+	output, err := conn.AuthorizeSecurityGroupIngress(input)
+
+	if err != nil {
+		return fmt.Errorf("error authorizing VPC Security Group (%s) Ingress Rule: %w", securityGroupID, err)
+	}
+
+	if len(output.SecurityGroupRules) == 0 || output.SecurityGroupRules[0] == nil {
+		return fmt.Errorf("error authorizing VPC Security Group (%s) Ingress Rule: empty result", securityGroupID)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroupRules[0].SecurityGroupRuleId))
+
+	return resourceVPCSecurityGroupIngressRuleRead(d, meta)
+}
+
+func resourceVPCSecurityGroupIngressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return resourceVPCSecurityGroupRuleRead(d, meta, securityGroupRuleTypeIngress)
+}
+
+func resourceVPCSecurityGroupIngressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceVPCSecurityGroupRuleUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return resourceVPCSecurityGroupIngressRuleRead(d, meta)
+}
+
+func resourceVPCSecurityGroupIngressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[INFO] Deleting VPC Security Group Ingress Rule: %s", d.Id())
+	_, err := conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId:              aws.String(d.Get("security_group_id").(string)),
+		SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidGroupNotFound, ErrCodeInvalidSecurityGroupIDNotFound, ErrCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking VPC Security Group Ingress Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func vpcSecurityGroupRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cidr_ipv4": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+		},
+		"cidr_ipv6": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+			ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+		},
+		"description": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 255),
+		},
+		"from_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+		"ip_protocol": {
+			Type:      schema.TypeString,
+			Required:  true,
+			StateFunc: ProtocolStateFunc,
+		},
+		"prefix_list_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+		},
+		"referenced_security_group_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+		},
+		"security_group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"security_group_rule_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags":     tftags.TagsSchema(),
+		"tags_all": tftags.TagsSchemaComputed(),
+		"to_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+	}
+}
+
+func resourceVPCSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindSecurityGroupRuleByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Security Group Rule (%s): %w", d.Id(), err)
+	}
+
+	rule := outputRaw.(*ec2.SecurityGroupRule)
+
+	if isEgress := aws.BoolValue(rule.IsEgress); isEgress != (ruleType == securityGroupRuleTypeEgress) {
+		return fmt.Errorf("VPC Security Group Rule (%s) is not an %s rule", d.Id(), ruleType)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	d.Set("ip_protocol", ProtocolForValue(aws.StringValue(rule.IpProtocol)))
+	d.Set("prefix_list_id", rule.PrefixListId)
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+
+	// Ports are not applicable to rules covering all protocols.
+	if aws.StringValue(rule.IpProtocol) == "-1" {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	} else {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	}
+
+	if v := rule.ReferencedGroupInfo; v != nil {
+		referencedSecurityGroupID := aws.StringValue(v.GroupId)
+
+		if userID := aws.StringValue(v.UserId); userID != "" && userID != aws.StringValue(rule.GroupOwnerId) {
+			referencedSecurityGroupID = userID + "/" + referencedSecurityGroupID
+		}
+
+		d.Set("referenced_security_group_id", referencedSecurityGroupID)
+	} else {
+		d.Set("referenced_security_group_id", nil)
+	}
+
+	tags := KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVPCSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("cidr_ipv4", "cidr_ipv6", "description", "from_port", "ip_protocol", "prefix_list_id", "referenced_security_group_id", "to_port") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandVPCSecurityGroupRuleRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Updating VPC Security Group Rule: %s", input)
+		_, err := conn.ModifySecurityGroupRules(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating VPC Security Group Rule (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating VPC Security Group Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandVPCSecurityGroupRuleIPPermission(d *schema.ResourceData) *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	var description *string
+
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOkExists("from_port"); ok {
+		apiObject.FromPort = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(v.(string)),
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		userIDGroupPair := &ec2.UserIdGroupPair{
+			Description: description,
+		}
+
+		if parts := strings.Split(v.(string), "/"); len(parts) == 2 {
+			userIDGroupPair.UserId = aws.String(parts[0])
+			userIDGroupPair.GroupId = aws.String(parts[1])
+		} else {
+			userIDGroupPair.GroupId = aws.String(v.(string))
+		}
+
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{userIDGroupPair}
+	}
+
+	if v, ok := d.GetOkExists("to_port"); ok {
+		apiObject.ToPort = aws.Int64(int64(v.(int)))
+	}
+
+	return apiObject
+}
+
+func expandVPCSecurityGroupRuleRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("from_port"); ok {
+		apiObject.FromPort = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		if parts := strings.Split(v.(string), "/"); len(parts) == 2 {
+			apiObject.ReferencedGroupId = aws.String(parts[1])
+		} else {
+			apiObject.ReferencedGroupId = aws.String(v.(string))
+		}
+	}
+
+	if v, ok := d.GetOkExists("to_port"); ok {
+		apiObject.ToPort = aws.Int64(int64(v.(int)))
+	}
+
+	return apiObject
+}
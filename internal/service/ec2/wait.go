@@ -1140,6 +1140,77 @@ const (
 	dhcpOptionSetDeletedTimeout = 3 * time.Minute
 )
 
+func WaitInstanceConnectEndpointCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.Ec2InstanceConnectEndpointStateCreateInProgress},
+		Target:  []string{ec2.Ec2InstanceConnectEndpointStateCreateComplete},
+		Refresh: StatusInstanceConnectEndpointState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Ec2InstanceConnectEndpoint); ok {
+		if state := aws.StringValue(output.State); state == ec2.Ec2InstanceConnectEndpointStateCreateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInstanceConnectEndpointDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.Ec2InstanceConnectEndpointStateDeleteInProgress},
+		Target:  []string{},
+		Refresh: StatusInstanceConnectEndpointState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Ec2InstanceConnectEndpoint); ok {
+		if state := aws.StringValue(output.State); state == ec2.Ec2InstanceConnectEndpointStateDeleteFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	networkInsightsAnalysisCreatedTimeout = 60 * time.Minute
+)
+
+func WaitNetworkInsightsAnalysisCreated(conn *ec2.EC2, id string) (*ec2.NetworkInsightsAnalysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.AnalysisStatusRunning},
+		Target:     []string{ec2.AnalysisStatusSucceeded},
+		Refresh:    StatusNetworkInsightsAnalysis(conn, id),
+		Timeout:    networkInsightsAnalysisCreatedTimeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NetworkInsightsAnalysis); ok {
+		if status := aws.StringValue(output.Status); status == ec2.AnalysisStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	internetGatewayAttachedTimeout = 4 * time.Minute
 	internetGatewayDeletedTimeout  = 10 * time.Minute
@@ -1537,3 +1608,189 @@ func WaitVolumeAttachmentAttached(conn *ec2.EC2, name, volumeID, instanceID stri
 
 	return err
 }
+
+func WaitTransitGatewayConnectCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStatePending},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Refresh: StatusTransitGatewayConnectState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayConnectDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStateAvailable, ec2.TransitGatewayAttachmentStateDeleting},
+		Target:  []string{},
+		Refresh: StatusTransitGatewayConnectState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayConnectPeerCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayConnectPeerStatePending},
+		Target:  []string{ec2.TransitGatewayConnectPeerStateAvailable},
+		Refresh: StatusTransitGatewayConnectPeerState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayConnectPeerDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayConnectPeerStateAvailable, ec2.TransitGatewayConnectPeerStateDeleting},
+		Target:  []string{},
+		Refresh: StatusTransitGatewayConnectPeerState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayPolicyTableCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayPolicyTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPolicyTableStatePending},
+		Target:  []string{ec2.TransitGatewayPolicyTableStateAvailable},
+		Refresh: StatusTransitGatewayPolicyTableState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayPolicyTableDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayPolicyTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPolicyTableStateAvailable, ec2.TransitGatewayPolicyTableStateDeleting},
+		Target:  []string{},
+		Refresh: StatusTransitGatewayPolicyTableState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayPolicyTable); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateCancelling},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetUpdated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	ImageBlockPublicAccessStateUpdatedTimeout    = 10 * time.Minute
+	SnapshotBlockPublicAccessStateUpdatedTimeout = 10 * time.Minute
+)
+
+func WaitImageBlockPublicAccessStateUpdated(conn *ec2.EC2, state string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   append(ec2.ImageBlockPublicAccessDisabledState_Values(), ec2.ImageBlockPublicAccessEnabledState_Values()...),
+		Target:                    []string{state},
+		Refresh:                   StatusImageBlockPublicAccessState(conn),
+		Timeout:                   ImageBlockPublicAccessStateUpdatedTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func WaitSnapshotBlockPublicAccessStateUpdated(conn *ec2.EC2, state string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   ec2.SnapshotBlockPublicAccessState_Values(),
+		Target:                    []string{state},
+		Refresh:                   StatusSnapshotBlockPublicAccessState(conn),
+		Timeout:                   SnapshotBlockPublicAccessStateUpdatedTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
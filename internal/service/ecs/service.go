@@ -332,6 +332,147 @@ func ResourceService() *schema.Resource {
 					ecs.SchedulingStrategyReplica,
 				}, false),
 			},
+			"service_connect_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_option": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value_from": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_alias": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dns_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"discovery_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"ingress_port_override": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"port_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"timeout": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"idle_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 2147483647),
+												},
+												"per_request_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 2147483647),
+												},
+											},
+										},
+									},
+									"tls": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"issuer_cert_authority": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"aws_pca_authority_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+														},
+													},
+												},
+												"kms_key": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -533,6 +674,10 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.PlacementConstraints = pc
 	}
 
+	if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.ServiceConnectConfiguration = expandServiceConnectConfiguration(v.([]interface{}))
+	}
+
 	serviceRegistries := d.Get("service_registries").([]interface{})
 	if len(serviceRegistries) > 0 {
 		srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
@@ -746,6 +891,9 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting network_configuration for (%s): %w", d.Id(), err)
 	}
 
+	// service_connect_configuration is not read back. DescribeServices only reports it on the
+	// individual deployments and normalizes the namespace to its ARN.
+
 	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
 		return fmt.Errorf("error setting service_registries for (%s): %w", d.Id(), err)
 	}
@@ -824,6 +972,155 @@ func flattenDeploymentCircuitBreaker(apiObject *ecs.DeploymentCircuitBreaker) ma
 	return tfMap
 }
 
+func expandServiceConnectConfiguration(tfList []interface{}) *ecs.ServiceConnectConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ecs.ServiceConnectConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandServiceConnectLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Services = expandServiceConnectServices(v)
+	}
+
+	return apiObject
+}
+
+func expandServiceConnectLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.LogConfiguration{}
+
+	if v, ok := tfMap["log_driver"].(string); ok && v != "" {
+		apiObject.LogDriver = aws.String(v)
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.SecretOptions = append(apiObject.SecretOptions, &ecs.Secret{
+				Name:      aws.String(tfMap["name"].(string)),
+				ValueFrom: aws.String(tfMap["value_from"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandServiceConnectServices(tfList []interface{}) []*ecs.ServiceConnectService {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ServiceConnectService
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceConnectService{}
+
+		if v, ok := tfMap["client_alias"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				clientAlias := &ecs.ServiceConnectClientAlias{
+					Port: aws.Int64(int64(tfMap["port"].(int))),
+				}
+
+				if v, ok := tfMap["dns_name"].(string); ok && v != "" {
+					clientAlias.DnsName = aws.String(v)
+				}
+
+				apiObject.ClientAliases = append(apiObject.ClientAliases, clientAlias)
+			}
+		}
+
+		if v, ok := tfMap["discovery_name"].(string); ok && v != "" {
+			apiObject.DiscoveryName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ingress_port_override"].(int); ok && v != 0 {
+			apiObject.IngressPortOverride = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["port_name"].(string); ok && v != "" {
+			apiObject.PortName = aws.String(v)
+		}
+
+		if v, ok := tfMap["timeout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			timeout := &ecs.TimeoutConfiguration{}
+
+			if v, ok := tfMap["idle_timeout_seconds"].(int); ok && v != 0 {
+				timeout.IdleTimeoutSeconds = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["per_request_timeout_seconds"].(int); ok && v != 0 {
+				timeout.PerRequestTimeoutSeconds = aws.Int64(int64(v))
+			}
+
+			apiObject.Timeout = timeout
+		}
+
+		if v, ok := tfMap["tls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			tls := &ecs.ServiceConnectTlsConfiguration{}
+
+			if v, ok := tfMap["issuer_cert_authority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tls.IssuerCertificateAuthority = &ecs.ServiceConnectTlsCertificateAuthority{
+					AwsPcaAuthorityArn: aws.String(v[0].(map[string]interface{})["aws_pca_authority_arn"].(string)),
+				}
+			}
+
+			if v, ok := tfMap["kms_key"].(string); ok && v != "" {
+				tls.KmsKey = aws.String(v)
+			}
+
+			if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+				tls.RoleArn = aws.String(v)
+			}
+
+			apiObject.Tls = tls
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func flattenNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -1109,6 +1406,18 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.EnableExecuteCommand = aws.Bool(d.Get("enable_execute_command").(bool))
 	}
 
+	if d.HasChange("service_connect_configuration") {
+		updateService = true
+		// To turn off Service Connect, specify a configuration that is not enabled.
+		input.ServiceConnectConfiguration = &ecs.ServiceConnectConfiguration{
+			Enabled: aws.Bool(false),
+		}
+
+		if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 {
+			input.ServiceConnectConfiguration = expandServiceConnectConfiguration(v.([]interface{}))
+		}
+	}
+
 	if updateService {
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
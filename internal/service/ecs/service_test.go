@@ -1272,6 +1272,41 @@ func TestAccECSService_executeCommand(t *testing.T) {
 	})
 }
 
+func TestAccECSService_serviceConnect(t *testing.T) {
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceServiceConnectConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.namespace", "aws_service_discovery_http_namespace.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.port_name", "http"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.client_alias.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.client_alias.0.port", "8080"),
+				),
+			},
+			{
+				Config: testAccServiceServiceConnectConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServiceDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
 
@@ -4287,3 +4322,59 @@ resource "aws_ecs_service" "test" {
 }
 `, rName, enable)
 }
+
+func testAccServiceServiceConnectConfig(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "nginx:latest",
+    "memory": 128,
+    "name": "web",
+    "portMappings": [
+      {
+        "containerPort": 80,
+        "name": "http"
+      }
+    ]
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  desired_count   = 0
+  name            = %[1]q
+  task_definition = aws_ecs_task_definition.test.arn
+
+  service_connect_configuration {
+    enabled   = %[2]t
+    namespace = aws_service_discovery_http_namespace.test.arn
+
+    service {
+      discovery_name = "web"
+      port_name      = "http"
+
+      client_alias {
+        dns_name = "web"
+        port     = 8080
+      }
+    }
+  }
+}
+`, rName, enabled)
+}
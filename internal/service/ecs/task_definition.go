@@ -256,6 +256,11 @@ func ResourceTaskDefinition() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"track_latest": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
 			"task_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -561,9 +566,15 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	trackedTaskDefinition := d.Get("arn").(string)
+	if d.Get("track_latest").(bool) {
+		// Describing the family returns its latest ACTIVE revision.
+		trackedTaskDefinition = d.Get("family").(string)
+	}
+
 	log.Printf("[DEBUG] Reading task definition %s", d.Id())
 	out, err := conn.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
-		TaskDefinition: aws.String(d.Get("arn").(string)),
+		TaskDefinition: aws.String(trackedTaskDefinition),
 		Include:        []*string{aws.String(ecs.TaskDefinitionFieldTags)},
 	})
 	if err != nil {
@@ -584,6 +595,7 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("arn", taskDefinition.TaskDefinitionArn)
 	d.Set("family", taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
+	d.Set("track_latest", d.Get("track_latest"))
 
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
@@ -63,6 +63,39 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionTrackLatestConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+					resource.TestCheckResourceAttr(resourceName, "track_latest", "true"),
+					testAccCheckTaskDefinitionRegisterRevision(&def),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionTrackLatestConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+					resource.TestCheckResourceAttr(resourceName, "track_latest", "true"),
+				),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/2370
 func TestAccECSTaskDefinition_scratchVolume(t *testing.T) {
 	var def ecs.TaskDefinition
@@ -1187,6 +1220,21 @@ func testAccCheckTaskDefinitionExists(name string, def *ecs.TaskDefinition) reso
 	}
 }
 
+// testAccCheckTaskDefinitionRegisterRevision registers a new revision of the task definition's
+// family outside of Terraform.
+func testAccCheckTaskDefinitionRegisterRevision(def *ecs.TaskDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+
+		_, err := conn.RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+			ContainerDefinitions: def.ContainerDefinitions,
+			Family:               def.Family,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckTaskDefinitionDockerVolumeConfigurationAutoprovisionNil(def *ecs.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(def.Volumes) != 1 {
@@ -2615,3 +2663,24 @@ resource "aws_fsx_windows_file_system" "test" {
 }
 `)
 }
+
+func testAccTaskDefinitionTrackLatestConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  track_latest = true
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "cpu": 10,
+    "essential": true,
+    "image": "jenkins",
+    "memory": 128,
+    "name": "jenkins"
+  }
+]
+TASK_DEFINITION
+}
+`, rName)
+}
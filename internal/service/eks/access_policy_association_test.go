@@ -0,0 +1,161 @@
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEKSAccessPolicyAssociation_basic(t *testing.T) {
+	var associatedAccessPolicy eks.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	eksClusterResourceName := "aws_eks_cluster.test"
+	iamRoleResourceName := "aws_iam_role.principal"
+	resourceName := "aws_eks_access_policy_association.test"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAccessPolicyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &associatedAccessPolicy),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.namespaces.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_scope.0.namespaces.*", "default"),
+					resource.TestCheckResourceAttr(resourceName, "access_scope.0.type", "namespace"),
+					resource.TestCheckResourceAttrSet(resourceName, "associated_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", eksClusterResourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "modified_at"),
+					resource.TestMatchResourceAttr(resourceName, "policy_arn", regexp.MustCompile(`:cluster-access-policy/AmazonEKSViewPolicy$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", iamRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessPolicyAssociation_disappears(t *testing.T) {
+	var associatedAccessPolicy eks.AssociatedAccessPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_association.test"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAccessPolicyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationExists(ctx, resourceName, &associatedAccessPolicy),
+					acctest.CheckResourceDisappears(acctest.Provider, tfeks.ResourceAccessPolicyAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessPolicyAssociationExists(ctx context.Context, resourceName string, associatedAccessPolicy *eks.AssociatedAccessPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Access Policy Association ID is set")
+		}
+
+		clusterName, principalARN, policyARN, err := tfeks.AccessPolicyAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+
+		output, err := tfeks.FindAssociatedAccessPolicyByClusterNamePrincipalARNAndPolicyARN(ctx, conn, clusterName, principalARN, policyARN)
+
+		if err != nil {
+			return err
+		}
+
+		*associatedAccessPolicy = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAccessPolicyAssociationDestroy(s *terraform.State) error {
+	ctx := context.TODO()
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eks_access_policy_association" {
+			continue
+		}
+
+		clusterName, principalARN, policyARN, err := tfeks.AccessPolicyAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfeks.FindAssociatedAccessPolicyByClusterNamePrincipalARNAndPolicyARN(ctx, conn, clusterName, principalARN, policyARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EKS Access Policy Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAccessPolicyAssociationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryBaseConfig(rName), `
+resource "aws_eks_access_entry" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_iam_role.principal.arn
+}
+
+resource "aws_eks_access_policy_association" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+  principal_arn = aws_eks_access_entry.test.principal_arn
+
+  access_scope {
+    type       = "namespace"
+    namespaces = ["default"]
+  }
+}
+`)
+}
@@ -60,6 +60,11 @@ func ResourceAddon() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validClusterName,
 			},
+			"configuration_values": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -68,10 +73,28 @@ func ResourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"preserve": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"resolve_conflicts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				Deprecated:    `Use "resolve_conflicts_on_create" and/or "resolve_conflicts_on_update" instead`,
+				ConflictsWith: []string{"resolve_conflicts_on_create", "resolve_conflicts_on_update"},
+			},
+			"resolve_conflicts_on_create": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{eks.ResolveConflictsNone, eks.ResolveConflictsOverwrite}, false),
+				ConflictsWith: []string{"resolve_conflicts"},
+			},
+			"resolve_conflicts_on_update": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				ConflictsWith: []string{"resolve_conflicts"},
 			},
 			"service_account_role_arn": {
 				Type:         schema.TypeString,
@@ -103,8 +126,14 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.AddonVersion = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("configuration_values"); ok {
+		input.ConfigurationValues = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("resolve_conflicts"); ok {
 		input.ResolveConflicts = aws.String(v.(string))
+	} else if v, ok := d.GetOk("resolve_conflicts_on_create"); ok {
+		input.ResolveConflicts = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("service_account_role_arn"); ok {
@@ -189,6 +218,7 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("addon_version", addon.AddonVersion)
 	d.Set("arn", addon.AddonArn)
 	d.Set("cluster_name", addon.ClusterName)
+	d.Set("configuration_values", addon.ConfigurationValues)
 	d.Set("created_at", aws.TimeValue(addon.CreatedAt).Format(time.RFC3339))
 	d.Set("modified_at", aws.TimeValue(addon.ModifiedAt).Format(time.RFC3339))
 	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
@@ -216,7 +246,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("addon_version", "service_account_role_arn") {
+	if d.HasChanges("addon_version", "configuration_values", "service_account_role_arn") {
 		input := &eks.UpdateAddonInput{
 			AddonName:          aws.String(addonName),
 			ClientRequestToken: aws.String(resource.UniqueId()),
@@ -227,8 +257,19 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.AddonVersion = aws.String(d.Get("addon_version").(string))
 		}
 
+		if d.HasChange("configuration_values") {
+			input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
+		}
+
+		var resolveConflicts string
 		if v, ok := d.GetOk("resolve_conflicts"); ok {
-			input.ResolveConflicts = aws.String(v.(string))
+			resolveConflicts = v.(string)
+		} else if v, ok := d.GetOk("resolve_conflicts_on_update"); ok {
+			resolveConflicts = v.(string)
+		}
+
+		if resolveConflicts != "" {
+			input.ResolveConflicts = aws.String(resolveConflicts)
 		}
 
 		// If service account role ARN is already provided, use it. Otherwise, the add-on uses
@@ -248,12 +289,12 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		_, err = waitAddonUpdateSuccessful(ctx, conn, clusterName, addonName, updateID)
 
 		if err != nil {
-			if d.Get("resolve_conflicts") != eks.ResolveConflictsOverwrite {
-				// Changing addon version w/o setting resolve_conflicts to "OVERWRITE"
+			if resolveConflicts != eks.ResolveConflictsOverwrite {
+				// Changing addon version w/o setting resolve_conflicts_on_update to "OVERWRITE"
 				// might result in a failed update if there are conflicts:
 				// ConfigurationConflict	Apply failed with 1 conflict: conflict with "kubectl"...
 				return diag.FromErr(fmt.Errorf("error waiting for EKS Add-On (%s) update (%s): %w, consider setting attribute %q to %q",
-					d.Id(), updateID, err, "resolve_conflicts", eks.ResolveConflictsOverwrite))
+					d.Id(), updateID, err, "resolve_conflicts_on_update", eks.ResolveConflictsOverwrite))
 			}
 
 			return diag.FromErr(fmt.Errorf("error waiting for EKS Add-On (%s) update (%s): %w", d.Id(), updateID, err))
@@ -283,8 +324,13 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	_, err = conn.DeleteAddonWithContext(ctx, &eks.DeleteAddonInput{
 		AddonName:   aws.String(addonName),
 		ClusterName: aws.String(clusterName),
+		Preserve:    aws.Bool(d.Get("preserve").(bool)),
 	})
 
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting EKS Add-On (%s): %w", d.Id(), err))
 	}
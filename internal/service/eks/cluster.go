@@ -47,6 +47,28 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(eks.AuthenticationMode_Values(), false),
+						},
+						"bootstrap_cluster_creator_admin_permissions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -252,6 +274,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		RoleArn:            aws.String(d.Get("role_arn").(string)),
 	}
 
+	if v, ok := d.GetOk("access_config"); ok {
+		input.AccessConfig = expandEksCreateAccessConfigRequest(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("kubernetes_network_config"); ok {
 		input.KubernetesNetworkConfig = expandEksNetworkConfigRequest(d.Get("kubernetes_network_config").([]interface{}))
 	}
@@ -338,6 +364,22 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading EKS Cluster (%s): %w", d.Id(), err)
 	}
 
+	// bootstrap_cluster_creator_admin_permissions is only honored on create and may not be returned.
+	// Fall back to the value in state, or the API default when importing.
+	if cluster.AccessConfig != nil && cluster.AccessConfig.BootstrapClusterCreatorAdminPermissions == nil {
+		bootstrap := true
+
+		if v, ok := d.Get("access_config").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			bootstrap = v[0].(map[string]interface{})["bootstrap_cluster_creator_admin_permissions"].(bool)
+		}
+
+		cluster.AccessConfig.BootstrapClusterCreatorAdminPermissions = aws.Bool(bootstrap)
+	}
+
+	if err := d.Set("access_config", flattenEksAccessConfigResponse(cluster.AccessConfig)); err != nil {
+		return fmt.Errorf("error setting access_config: %w", err)
+	}
+
 	d.Set("arn", cluster.Arn)
 
 	if err := d.Set("certificate_authority", flattenEksCertificate(cluster.CertificateAuthority)); err != nil {
@@ -414,6 +456,30 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("access_config.0.authentication_mode") {
+		input := &eks.UpdateClusterConfigInput{
+			AccessConfig: &eks.UpdateAccessConfigRequest{
+				AuthenticationMode: aws.String(d.Get("access_config.0.authentication_mode").(string)),
+			},
+			Name: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating EKS Cluster (%s) access config: %s", d.Id(), input)
+		output, err := conn.UpdateClusterConfig(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EKS Cluster (%s) access config: %w", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return fmt.Errorf("error waiting for EKS Cluster (%s) access config update (%s): %w", d.Id(), updateID, err)
+		}
+	}
+
 	if d.HasChange("encryption_config") {
 		o, n := d.GetChange("encryption_config")
 
@@ -525,6 +591,26 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func expandEksCreateAccessConfigRequest(tfList []interface{}) *eks.CreateAccessConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &eks.CreateAccessConfigRequest{}
+
+	if v, ok := tfMap["authentication_mode"].(string); ok && v != "" {
+		apiObject.AuthenticationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["bootstrap_cluster_creator_admin_permissions"].(bool); ok {
+		apiObject.BootstrapClusterCreatorAdminPermissions = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandEksEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -650,6 +736,19 @@ func expandEksLoggingTypes(vEnabledLogTypes *schema.Set) *eks.Logging {
 	}
 }
 
+func flattenEksAccessConfigResponse(apiObject *eks.AccessConfigResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authentication_mode":                         aws.StringValue(apiObject.AuthenticationMode),
+		"bootstrap_cluster_creator_admin_permissions": aws.BoolValue(apiObject.BootstrapClusterCreatorAdminPermissions),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenEksCertificate(certificate *eks.Certificate) []map[string]interface{} {
 	if certificate == nil {
 		return []map[string]interface{}{}
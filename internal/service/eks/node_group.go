@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -407,7 +408,33 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("error setting labels: %s", err)
 	}
 
-	if err := d.Set("launch_template", flattenEksLaunchTemplateSpecification(nodeGroup.LaunchTemplate)); err != nil {
+	launchTemplate := flattenEksLaunchTemplateSpecification(nodeGroup.LaunchTemplate)
+
+	// The API resolves $Default and $Latest to a version number. Keep the
+	// configured alias while it still points at the node group's version so
+	// that only a new launch template version triggers a rollout.
+	if v, ok := d.GetOk("launch_template.0.version"); ok && len(launchTemplate) > 0 {
+		if stateVersion := v.(string); stateVersion == "$Default" || stateVersion == "$Latest" {
+			lt, err := findLaunchTemplateByID(meta.(*conns.AWSClient).EC2Conn, aws.StringValue(nodeGroup.LaunchTemplate.Id))
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				return diag.Errorf("error reading EKS Node Group (%s) launch template (%s): %s", d.Id(), aws.StringValue(nodeGroup.LaunchTemplate.Id), err)
+			default:
+				versionNumber := aws.Int64Value(lt.DefaultVersionNumber)
+				if stateVersion == "$Latest" {
+					versionNumber = aws.Int64Value(lt.LatestVersionNumber)
+				}
+
+				if launchTemplate[0]["version"] == strconv.FormatInt(versionNumber, 10) {
+					launchTemplate[0]["version"] = stateVersion
+				}
+			}
+		}
+	}
+
+	if err := d.Set("launch_template", launchTemplate); err != nil {
 		return diag.Errorf("error setting launch_template: %s", err)
 	}
 
@@ -496,6 +496,49 @@ func TestAccEKSNodeGroup_LaunchTemplate_version(t *testing.T) {
 	})
 }
 
+func TestAccEKSNodeGroup_LaunchTemplate_versionLatest(t *testing.T) {
+	var nodeGroup1, nodeGroup2 eks.Nodegroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeGroupLaunchTemplateVersionLatestConfig(rName, "t3.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(resourceName, &nodeGroup1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Latest"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"launch_template.0.version"},
+			},
+			// A new launch template version is only detected on the next refresh.
+			{
+				Config:             testAccNodeGroupLaunchTemplateVersionLatestConfig(rName, "t3.large"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccNodeGroupLaunchTemplateVersionLatestConfig(rName, "t3.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(resourceName, &nodeGroup2),
+					testAccCheckNodeGroupNotRecreated(&nodeGroup1, &nodeGroup2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.0.version", "$Latest"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEKSNodeGroup_releaseVersion(t *testing.T) {
 	var nodeGroup1, nodeGroup2 eks.Nodegroup
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1784,6 +1827,47 @@ resource "aws_eks_node_group" "test" {
 `, rName))
 }
 
+func testAccNodeGroupLaunchTemplateVersionLatestConfig(rName, instanceType string) string {
+	return acctest.ConfigCompose(
+		testAccNodeGroupBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_ssm_parameter" "test" {
+  name = "/aws/service/eks/optimized-ami/${aws_eks_cluster.test.version}/amazon-linux-2/recommended/image_id"
+}
+
+resource "aws_launch_template" "test" {
+  image_id      = data.aws_ssm_parameter.test.value
+  instance_type = %[2]q
+  name          = %[1]q
+  user_data     = base64encode(templatefile("testdata/node-group-launch-template-user-data.sh.tmpl", { cluster_name = aws_eks_cluster.test.name }))
+}
+
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = "$Latest"
+  }
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 1
+    min_size     = 1
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+  ]
+}
+`, rName, instanceType))
+}
+
 func testAccNodeGroupReleaseVersionConfig(rName string, version string) string {
 	return acctest.ConfigCompose(testAccNodeGroupBaseVersionConfig(rName, version), fmt.Sprintf(`
 data "aws_ssm_parameter" "test" {
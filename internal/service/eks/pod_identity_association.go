@@ -0,0 +1,202 @@
+package eks
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePodIdentityAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePodIdentityAssociationCreate,
+		ReadWithoutTimeout:   resourcePodIdentityAssociationRead,
+		UpdateWithoutTimeout: resourcePodIdentityAssociationUpdate,
+		DeleteWithoutTimeout: resourcePodIdentityAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"association_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"service_account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 253),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourcePodIdentityAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &eks.CreatePodIdentityAssociationInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		Namespace:          aws.String(d.Get("namespace").(string)),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		ServiceAccount:     aws.String(d.Get("service_account").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreatePodIdentityAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating EKS Pod Identity Association (%s): %s", clusterName, err)
+	}
+
+	d.SetId(PodIdentityAssociationCreateResourceID(clusterName, aws.StringValue(output.Association.AssociationId)))
+
+	return resourcePodIdentityAssociationRead(ctx, d, meta)
+}
+
+func resourcePodIdentityAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := FindPodIdentityAssociationByClusterNameAndAssociationID(ctx, conn, clusterName, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Pod Identity Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("association_arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("cluster_name", association.ClusterName)
+	d.Set("namespace", association.Namespace)
+	d.Set("role_arn", association.RoleArn)
+	d.Set("service_account", association.ServiceAccount)
+
+	tags := KeyValueTags(association.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePodIdentityAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	if d.HasChange("role_arn") {
+		clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &eks.UpdatePodIdentityAssociationInput{
+			AssociationId:      aws.String(associationID),
+			ClientRequestToken: aws.String(resource.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+		}
+
+		_, err = conn.UpdatePodIdentityAssociationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating EKS Pod Identity Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("association_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourcePodIdentityAssociationRead(ctx, d, meta)
+}
+
+func resourcePodIdentityAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Pod Identity Association: %s", d.Id())
+	_, err = conn.DeletePodIdentityAssociationWithContext(ctx, &eks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEKSPodIdentityAssociation_basic(t *testing.T) {
+	var association eks.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	eksClusterResourceName := "aws_eks_cluster.test"
+	iamRoleResourceName := "aws_iam_role.pod1"
+	resourceName := "aws_eks_pod_identity_association.test"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPodIdentityAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig(rName, "pod1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, "association_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", eksClusterResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "service_account", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociation_disappears(t *testing.T) {
+	var association eks.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPodIdentityAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig(rName, "pod1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(acctest.Provider, tfeks.ResourcePodIdentityAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociation_roleARN(t *testing.T) {
+	var association eks.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPodIdentityAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig(rName, "pod1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.pod1", "arn"),
+				),
+			},
+			{
+				Config: testAccPodIdentityAssociationConfig(rName, "pod2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.pod2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociation_tags(t *testing.T) {
+	var association eks.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+	ctx := context.TODO()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPodIdentityAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPodIdentityAssociationTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPodIdentityAssociationTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPodIdentityAssociationExists(ctx context.Context, resourceName string, association *eks.PodIdentityAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Pod Identity Association ID is set")
+		}
+
+		clusterName, associationID, err := tfeks.PodIdentityAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+
+		output, err := tfeks.FindPodIdentityAssociationByClusterNameAndAssociationID(ctx, conn, clusterName, associationID)
+
+		if err != nil {
+			return err
+		}
+
+		*association = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPodIdentityAssociationDestroy(s *terraform.State) error {
+	ctx := context.TODO()
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eks_pod_identity_association" {
+			continue
+		}
+
+		clusterName, associationID, err := tfeks.PodIdentityAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfeks.FindPodIdentityAssociationByClusterNameAndAssociationID(ctx, conn, clusterName, associationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EKS Pod Identity Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPodIdentityAssociationBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), fmt.Sprintf(`
+resource "aws_iam_role" "pod1" {
+  name = "%[1]s-pod1"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:TagSession"]
+      Effect = "Allow"
+      Principal = {
+        Service = "pods.eks.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role" "pod2" {
+  name = "%[1]s-pod2"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:TagSession"]
+      Effect = "Allow"
+      Principal = {
+        Service = "pods.eks.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+`, rName))
+}
+
+func testAccPodIdentityAssociationConfig(rName, roleName string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  namespace       = "default"
+  service_account = "test"
+  role_arn        = aws_iam_role.%[1]s.arn
+}
+`, roleName))
+}
+
+func testAccPodIdentityAssociationTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  namespace       = "default"
+  service_account = "test"
+  role_arn        = aws_iam_role.pod1.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccPodIdentityAssociationTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPodIdentityAssociationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_eks_pod_identity_association" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  namespace       = "default"
+  service_account = "test"
+  role_arn        = aws_iam_role.pod1.arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -134,6 +134,7 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"log_delivery_configuration": logDeliveryConfigurationSchema(),
 			"maintenance_window": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -277,6 +278,37 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
+func logDeliveryConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 2,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"destination_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(elasticache.DestinationType_Values(), false),
+				},
+				"log_format": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(elasticache.LogFormat_Values(), false),
+				},
+				"log_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(elasticache.LogType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
 func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -341,6 +373,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		req.NotificationTopicArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("log_delivery_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		req.LogDeliveryConfigurations = expandLogDeliveryConfigurationRequests(v.(*schema.Set).List())
+	}
+
 	snaps := d.Get("snapshot_arns").([]interface{})
 	if len(snaps) > 0 {
 		req.SnapshotArns = flex.ExpandStringList(snaps)
@@ -432,6 +468,10 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("log_delivery_configuration", flattenLogDeliveryConfigurations(c.LogDeliveryConfigurations)); err != nil {
+		return fmt.Errorf("error setting log_delivery_configuration: %w", err)
+	}
+
 	d.Set("arn", c.ARN)
 
 	tags, err := ListTags(conn, aws.StringValue(c.ARN))
@@ -528,6 +568,12 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("log_delivery_configuration") {
+		o, n := d.GetChange("log_delivery_configuration")
+		req.LogDeliveryConfigurations = expandLogDeliveryConfigurationChanges(o.(*schema.Set), n.(*schema.Set))
+		requestUpdate = true
+	}
+
 	if d.HasChange("notification_topic_arn") {
 		v := d.Get("notification_topic_arn").(string)
 		req.NotificationTopicArn = aws.String(v)
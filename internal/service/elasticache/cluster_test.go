@@ -811,6 +811,58 @@ func testAccCheckClusterDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestAccElastiCacheCluster_Redis_logDeliveryConfiguration(t *testing.T) {
+	var ec elasticache.CacheCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_LogDeliveryConfiguration(rName, true, "text"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &ec),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"destination":      rName,
+						"destination_type": "cloudwatch-logs",
+						"log_format":       "text",
+						"log_type":         "slow-log",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+			{
+				Config: testAccClusterConfig_LogDeliveryConfiguration(rName, true, "json"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &ec),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"log_format": "json",
+						"log_type":   "slow-log",
+					}),
+				),
+			},
+			{
+				Config: testAccClusterConfig_LogDeliveryConfiguration(rName, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &ec),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterExists(n string, v *elasticache.CacheCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1376,3 +1428,32 @@ resource "aws_elasticache_cluster" "test" {
 }
 `, rName)
 }
+
+func testAccClusterConfig_LogDeliveryConfiguration(rName string, enabled bool, logFormat string) string {
+	var logDeliveryConfiguration string
+	if enabled {
+		logDeliveryConfiguration = fmt.Sprintf(`
+  log_delivery_configuration {
+    destination      = aws_cloudwatch_log_group.test.name
+    destination_type = "cloudwatch-logs"
+    log_format       = %q
+    log_type         = "slow-log"
+  }
+`, logFormat)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_elasticache_cluster" "test" {
+  cluster_id        = %[1]q
+  engine            = "redis"
+  node_type         = "cache.t3.small"
+  num_cache_nodes   = 1
+  apply_immediately = true
+%[2]s
+}
+`, rName, logDeliveryConfiguration)
+}
@@ -0,0 +1,59 @@
+package elasticache
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceDefaultParameter() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDefaultParameterRead,
+
+		Schema: map[string]*schema.Schema{
+			"change_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_modifiable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDefaultParameterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	family := d.Get("family").(string)
+	name := d.Get("name").(string)
+
+	parameter, err := FindEngineDefaultParameterByFamilyAndName(conn, family, name)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("ElastiCache Default Parameter", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", family, name))
+	d.Set("change_type", parameter.ChangeType)
+	d.Set("is_modifiable", parameter.IsModifiable)
+	d.Set("value", aws.StringValue(parameter.ParameterValue))
+
+	return nil
+}
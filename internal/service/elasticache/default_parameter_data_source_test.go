@@ -0,0 +1,56 @@
+package elasticache_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElastiCacheDefaultParameterDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_elasticache_default_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultParameterDataSourceConfig("redis6.x", "maxmemory-policy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "change_type", "immediate"),
+					resource.TestCheckResourceAttr(dataSourceName, "family", "redis6.x"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_modifiable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "maxmemory-policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "value", "volatile-lru"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheDefaultParameterDataSource_notFound(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDefaultParameterDataSourceConfig("redis6.x", "tf-acc-test-does-not-exist"),
+				ExpectError: regexp.MustCompile(`no matching ElastiCache Default Parameter found`),
+			},
+		},
+	})
+}
+
+func testAccDefaultParameterDataSourceConfig(family, name string) string {
+	return fmt.Sprintf(`
+data "aws_elasticache_default_parameter" "test" {
+  family = %[1]q
+  name   = %[2]q
+}
+`, family, name)
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindReplicationGroupByID retrieves an ElastiCache Replication Group by id.
@@ -126,6 +128,34 @@ func FindCacheClustersByID(conn *elasticache.ElastiCache, idList []string) ([]*e
 	return results, err
 }
 
+// FindCacheClustersByParameterGroupName retrieves all ElastiCache Cache Clusters associated with a Parameter Group.
+func FindCacheClustersByParameterGroupName(conn *elasticache.ElastiCache, name string) ([]*elasticache.CacheCluster, error) {
+	var results []*elasticache.CacheCluster
+
+	input := &elasticache.DescribeCacheClustersInput{
+		ShowCacheNodeInfo: aws.Bool(true),
+	}
+	err := conn.DescribeCacheClustersPages(input, func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CacheClusters {
+			if v == nil || v.CacheParameterGroup == nil {
+				continue
+			}
+
+			if aws.StringValue(v.CacheParameterGroup.CacheParameterGroupName) == name {
+				results = append(results, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return results, err
+}
+
 // FindGlobalReplicationGroupByID() retrieves an ElastiCache Global Replication Group by id.
 func FindGlobalReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.GlobalReplicationGroup, error) {
 	input := &elasticache.DescribeGlobalReplicationGroupsInput{
@@ -180,6 +210,138 @@ func FindGlobalReplicationGroupMemberByID(conn *elasticache.ElastiCache, globalR
 	}
 }
 
+// FindParameterGroupByName retrieves an ElastiCache Parameter Group by name.
+func FindParameterGroupByName(conn *elasticache.ElastiCache, name string) (*elasticache.CacheParameterGroup, error) {
+	input := &elasticache.DescribeCacheParameterGroupsInput{
+		CacheParameterGroupName: aws.String(name),
+	}
+
+	outputRaw, err := retryWhenParameterGroupThrottled(func() (interface{}, error) {
+		return conn.DescribeCacheParameterGroups(input)
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeCacheParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := outputRaw.(*elasticache.DescribeCacheParameterGroupsOutput)
+
+	if output == nil || len(output.CacheParameterGroups) == 0 || output.CacheParameterGroups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CacheParameterGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	parameterGroup := output.CacheParameterGroups[0]
+
+	if aws.StringValue(parameterGroup.CacheParameterGroupName) != name {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return parameterGroup, nil
+}
+
+// FindParameterGroupUserParametersByName retrieves the user customized parameters of an ElastiCache Parameter Group by name.
+func FindParameterGroupUserParametersByName(conn *elasticache.ElastiCache, name string) ([]*elasticache.Parameter, error) {
+	return FindParameterGroupParametersByNameAndSource(conn, name, "user")
+}
+
+// FindParameterGroupParametersByNameAndSource retrieves the parameters of an ElastiCache Parameter Group by name.
+// An empty source retrieves parameters from all sources.
+func FindParameterGroupParametersByNameAndSource(conn *elasticache.ElastiCache, name, source string) ([]*elasticache.Parameter, error) {
+	input := &elasticache.DescribeCacheParametersInput{
+		CacheParameterGroupName: aws.String(name),
+	}
+
+	if source != "" {
+		input.Source = aws.String(source)
+	}
+
+	var parameters []*elasticache.Parameter
+	_, err := retryWhenParameterGroupThrottled(func() (interface{}, error) {
+		parameters = nil
+
+		return nil, conn.DescribeCacheParametersPages(input, func(page *elasticache.DescribeCacheParametersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			parameters = append(parameters, page.Parameters...)
+
+			return !lastPage
+		})
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeCacheParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
+
+// FindEngineDefaultParameterByFamilyAndName retrieves an ElastiCache engine default parameter by family and name.
+func FindEngineDefaultParameterByFamilyAndName(conn *elasticache.ElastiCache, family, name string) (*elasticache.Parameter, error) {
+	parameters, err := FindEngineDefaultParametersByFamily(conn, family)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range parameters {
+		if aws.StringValue(v.ParameterName) == name {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: fmt.Sprintf("ElastiCache engine default parameter (%s) not found in family %s", name, family),
+	}
+}
+
+// FindEngineDefaultParametersByFamily retrieves all ElastiCache engine default parameters for a family.
+func FindEngineDefaultParametersByFamily(conn *elasticache.ElastiCache, family string) ([]*elasticache.Parameter, error) {
+	input := &elasticache.DescribeEngineDefaultParametersInput{
+		CacheParameterGroupFamily: aws.String(family),
+	}
+
+	var parameters []*elasticache.Parameter
+	err := conn.DescribeEngineDefaultParametersPages(input, func(page *elasticache.DescribeEngineDefaultParametersOutput, lastPage bool) bool {
+		if page == nil || page.EngineDefaults == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EngineDefaults.Parameters {
+			if v != nil {
+				parameters = append(parameters, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
+
 func FindElastiCacheUserByID(conn *elasticache.ElastiCache, userID string) (*elasticache.User, error) {
 	input := &elasticache.DescribeUsersInput{
 		UserId: aws.String(userID),
@@ -226,3 +388,158 @@ func FindElastiCacheUserGroupByID(conn *elasticache.ElastiCache, groupID string)
 		}
 	}
 }
+
+// FindReplicationGroupScalableTarget retrieves the Application Auto Scaling scalable target registered for
+// an ElastiCache Replication Group in the given scalable dimension.
+func FindReplicationGroupScalableTarget(conn *applicationautoscaling.ApplicationAutoScaling, id, dimension string) (*applicationautoscaling.ScalableTarget, error) {
+	input := &applicationautoscaling.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{replicationGroupAutoScalingResourceID(id)}),
+		ScalableDimension: aws.String(dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceElasticache),
+	}
+
+	output, err := conn.DescribeScalableTargets(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, target := range output.ScalableTargets {
+		if target != nil {
+			return target, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// FindReplicationGroupScalingPolicy retrieves an Application Auto Scaling scaling policy
+// attached to an ElastiCache Replication Group's scalable target.
+func FindReplicationGroupScalingPolicy(conn *applicationautoscaling.ApplicationAutoScaling, id, dimension, name string) (*applicationautoscaling.ScalingPolicy, error) {
+	input := &applicationautoscaling.DescribeScalingPoliciesInput{
+		PolicyNames:       aws.StringSlice([]string{name}),
+		ResourceId:        aws.String(replicationGroupAutoScalingResourceID(id)),
+		ScalableDimension: aws.String(dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceElasticache),
+	}
+
+	output, err := conn.DescribeScalingPolicies(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, policy := range output.ScalingPolicies {
+		if policy != nil && aws.StringValue(policy.PolicyName) == name {
+			return policy, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// FindReservedCacheNodeByID retrieves an ElastiCache Reserved Cache Node by reservation id.
+func FindReservedCacheNodeByID(conn *elasticache.ElastiCache, id string) (*elasticache.ReservedCacheNode, error) {
+	input := &elasticache.DescribeReservedCacheNodesInput{
+		ReservedCacheNodeId: aws.String(id),
+	}
+
+	output, err := conn.DescribeReservedCacheNodes(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeReservedCacheNodeNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ReservedCacheNodes) == 0 || output.ReservedCacheNodes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ReservedCacheNodes); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ReservedCacheNodes[0], nil
+}
+
+// FindReservedCacheNodesOffering retrieves the single ElastiCache Reserved Cache Nodes Offering matching the input.
+func FindReservedCacheNodesOffering(conn *elasticache.ElastiCache, input *elasticache.DescribeReservedCacheNodesOfferingsInput) (*elasticache.ReservedCacheNodesOffering, error) {
+	var offerings []*elasticache.ReservedCacheNodesOffering
+
+	err := conn.DescribeReservedCacheNodesOfferingsPages(input, func(page *elasticache.DescribeReservedCacheNodesOfferingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReservedCacheNodesOfferings {
+			if v != nil {
+				offerings = append(offerings, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeReservedCacheNodesOfferingNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(offerings) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(offerings); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return offerings[0], nil
+}
+
+// FindServerlessCacheByName retrieves an ElastiCache Serverless Cache by name.
+func FindServerlessCacheByName(conn *elasticache.ElastiCache, name string) (*elasticache.ServerlessCache, error) {
+	input := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: aws.String(name),
+	}
+
+	output, err := conn.DescribeServerlessCaches(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ServerlessCaches) == 0 || output.ServerlessCaches[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ServerlessCaches); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ServerlessCaches[0], nil
+}
@@ -1,7 +1,10 @@
 package elasticache
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func flattenSecurityGroupIDs(securityGroups []*elasticache.SecurityGroupMembership) []string {
@@ -23,3 +26,166 @@ func flattenSecurityGroupNames(securityGroups []*elasticache.CacheSecurityGroupM
 	}
 	return result
 }
+
+func expandLogDeliveryConfigurationRequest(tfMap map[string]interface{}) *elasticache.LogDeliveryConfigurationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elasticache.LogDeliveryConfigurationRequest{
+		DestinationDetails: &elasticache.DestinationDetails{},
+		Enabled:            aws.Bool(true),
+	}
+
+	if v, ok := tfMap["destination_type"].(string); ok && v != "" {
+		apiObject.DestinationType = aws.String(v)
+
+		destination := tfMap["destination"].(string)
+
+		switch v {
+		case elasticache.DestinationTypeCloudwatchLogs:
+			apiObject.DestinationDetails.CloudWatchLogsDetails = &elasticache.CloudWatchLogsDestinationDetails{
+				LogGroup: aws.String(destination),
+			}
+		case elasticache.DestinationTypeKinesisFirehose:
+			apiObject.DestinationDetails.KinesisFirehoseDetails = &elasticache.KinesisFirehoseDestinationDetails{
+				DeliveryStream: aws.String(destination),
+			}
+		}
+	}
+
+	if v, ok := tfMap["log_format"].(string); ok && v != "" {
+		apiObject.LogFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_type"].(string); ok && v != "" {
+		apiObject.LogType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLogDeliveryConfigurationRequests(tfList []interface{}) []*elasticache.LogDeliveryConfigurationRequest {
+	var apiObjects []*elasticache.LogDeliveryConfigurationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandLogDeliveryConfigurationRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+// expandLogDeliveryConfigurationChanges returns the requests that enable the new
+// log delivery configurations and disable any log types no longer configured.
+func expandLogDeliveryConfigurationChanges(o, n *schema.Set) []*elasticache.LogDeliveryConfigurationRequest {
+	apiObjects := expandLogDeliveryConfigurationRequests(n.List())
+	logTypes := make(map[string]bool)
+
+	for _, apiObject := range apiObjects {
+		logTypes[aws.StringValue(apiObject.LogType)] = true
+	}
+
+	for _, tfMapRaw := range o.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		logType := tfMap["log_type"].(string)
+
+		if logTypes[logType] {
+			continue
+		}
+
+		logTypes[logType] = true
+		apiObjects = append(apiObjects, &elasticache.LogDeliveryConfigurationRequest{
+			Enabled: aws.Bool(false),
+			LogType: aws.String(logType),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLogDeliveryConfigurations(apiObjects []*elasticache.LogDeliveryConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || aws.StringValue(apiObject.Status) == elasticache.LogDeliveryConfigurationStatusDisabling {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"destination_type": aws.StringValue(apiObject.DestinationType),
+			"log_format":       aws.StringValue(apiObject.LogFormat),
+			"log_type":         aws.StringValue(apiObject.LogType),
+		}
+
+		if v := apiObject.DestinationDetails; v != nil {
+			switch aws.StringValue(apiObject.DestinationType) {
+			case elasticache.DestinationTypeCloudwatchLogs:
+				if v.CloudWatchLogsDetails != nil {
+					tfMap["destination"] = aws.StringValue(v.CloudWatchLogsDetails.LogGroup)
+				}
+			case elasticache.DestinationTypeKinesisFirehose:
+				if v.KinesisFirehoseDetails != nil {
+					tfMap["destination"] = aws.StringValue(v.KinesisFirehoseDetails.DeliveryStream)
+				}
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandReplicationGroupTargetTrackingScalingPolicyConfiguration(tfMap map[string]interface{}) *applicationautoscaling.TargetTrackingScalingPolicyConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+		DisableScaleIn: aws.Bool(tfMap["disable_scale_in"].(bool)),
+		PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String(tfMap["predefined_metric_type"].(string)),
+		},
+		TargetValue: aws.Float64(tfMap["target_value"].(float64)),
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok && v > 0 {
+		apiObject.ScaleInCooldown = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok && v > 0 {
+		apiObject.ScaleOutCooldown = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenReplicationGroupTargetTrackingScalingPolicyConfiguration(apiObject *applicationautoscaling.TargetTrackingScalingPolicyConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"disable_scale_in":   aws.BoolValue(apiObject.DisableScaleIn),
+		"scale_in_cooldown":  aws.Int64Value(apiObject.ScaleInCooldown),
+		"scale_out_cooldown": aws.Int64Value(apiObject.ScaleOutCooldown),
+		"target_value":       aws.Float64Value(apiObject.TargetValue),
+	}
+
+	if v := apiObject.PredefinedMetricSpecification; v != nil {
+		tfMap["predefined_metric_type"] = aws.StringValue(v.PredefinedMetricType)
+	}
+
+	return []interface{}{tfMap}
+}
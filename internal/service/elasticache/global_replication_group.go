@@ -1,6 +1,7 @@
 package elasticache
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -122,7 +123,6 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateReplicationGroupID,
 			},
 			"transit_encryption_enabled": {
@@ -130,7 +130,36 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID,
+	}
+}
+
+// customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID causes re-creation of the resource if
+// `primary_replication_group_id` is changed to a replication group that is not a secondary member,
+// as only secondary members can be promoted to primary by failing over.
+func customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("primary_replication_group_id") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	member, err := FindGlobalReplicationGroupMemberByID(conn, diff.Id(), diff.Get("primary_replication_group_id").(string))
+
+	if tfresource.NotFound(err) {
+		return diff.ForceNew("primary_replication_group_id")
 	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s) members: %w", diff.Id(), err)
+	}
+
+	if aws.StringValue(member.Role) != GlobalReplicationGroupMemberRoleSecondary {
+		return diff.ForceNew("primary_replication_group_id")
+	}
+
+	return nil
 }
 
 func elasticacheDescriptionDiffSuppress(_, old, new string, d *schema.ResourceData) bool {
@@ -229,6 +258,12 @@ func resourceGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if !d.IsNewResource() && d.HasChange("primary_replication_group_id") {
+		if err := failoverElasticacheGlobalReplicationGroup(conn, d.Id(), d.Get("primary_replication_group_id").(string)); err != nil {
+			return fmt.Errorf("error failing over ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+		}
+	}
+
 	return resourceGlobalReplicationGroupRead(d, meta)
 }
 
@@ -252,6 +287,46 @@ func updateElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id s
 	return nil
 }
 
+// failoverElasticacheGlobalReplicationGroup promotes a secondary member replication group to primary.
+func failoverElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id, primaryReplicationGroupID string) error {
+	globalReplicationGroup, err := FindGlobalReplicationGroupByID(conn, id)
+	if err != nil {
+		return err
+	}
+
+	var primaryRegion string
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.ReplicationGroupId) == primaryReplicationGroupID {
+			primaryRegion = aws.StringValue(member.ReplicationGroupRegion)
+			break
+		}
+	}
+
+	if primaryRegion == "" {
+		return fmt.Errorf("replication group (%s) is not a member", primaryReplicationGroupID)
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             aws.String(primaryRegion),
+		PrimaryReplicationGroupId: aws.String(primaryReplicationGroupID),
+	}
+
+	if _, err := conn.FailoverGlobalReplicationGroup(input); err != nil {
+		return err
+	}
+
+	if _, err := WaitGlobalReplicationGroupAvailable(conn, id, GlobalReplicationGroupDefaultUpdatedTimeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	if _, err := WaitGlobalReplicationGroupMemberPrimary(conn, id, primaryReplicationGroupID, GlobalReplicationGroupDefaultUpdatedTimeout); err != nil {
+		return fmt.Errorf("waiting for replication group (%s) to become primary: %w", primaryReplicationGroupID, err)
+	}
+
+	return nil
+}
+
 func resourceGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
@@ -149,6 +149,41 @@ func TestAccElastiCacheGlobalReplicationGroup_multipleSecondaries(t *testing.T)
 	})
 }
 
+func TestAccElastiCacheGlobalReplicationGroup_failover(t *testing.T) {
+	var providers []*schema.Provider
+	var globalReplcationGroup elasticache.GlobalReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProviderFactories: acctest.FactoriesMultipleRegion(&providers, 2),
+		CheckDestroy:      testAccCheckReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalReplicationGroupConfig_Failover(rName, "p"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(resourceName, &globalReplcationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", rName+"-p"),
+				),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_Failover(rName, "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(resourceName, &globalReplcationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", rName+"-a"),
+					testAccCheckGlobalReplicationGroupMemberRole(&globalReplcationGroup, rName+"-a", tfelasticache.GlobalReplicationGroupMemberRolePrimary),
+					testAccCheckGlobalReplicationGroupMemberRole(&globalReplcationGroup, rName+"-p", tfelasticache.GlobalReplicationGroupMemberRoleSecondary),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheGlobalReplicationGroup_ReplaceSecondary_differentRegion(t *testing.T) {
 	var providers []*schema.Provider
 	var globalReplcationGroup elasticache.GlobalReplicationGroup
@@ -239,6 +274,24 @@ func testAccCheckGlobalReplicationGroupExists(resourceName string, v *elasticach
 	}
 }
 
+func testAccCheckGlobalReplicationGroupMemberRole(v *elasticache.GlobalReplicationGroup, id, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, member := range v.Members {
+			if aws.StringValue(member.ReplicationGroupId) != id {
+				continue
+			}
+
+			if got := aws.StringValue(member.Role); got != role {
+				return fmt.Errorf("expected ElastiCache Global Replication Group member (%s) role %s, got %s", id, role, got)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("ElastiCache Replication Group (%s) is not a member of Global Replication Group (%s)", id, aws.StringValue(v.GlobalReplicationGroupId))
+	}
+}
+
 func testAccCheckGlobalReplicationGroupDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
 
@@ -371,6 +424,56 @@ resource "aws_elasticache_replication_group" "third" {
 `, rName))
 }
 
+// The primary replication group ID is built from rName rather than referenced
+// so the secondary can be promoted without a dependency cycle.
+func testAccGlobalReplicationGroupConfig_Failover(rName, primarySuffix string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		testAccElasticacheVpcBaseWithProvider(rName, "primary", acctest.ProviderName, 1),
+		testAccElasticacheVpcBaseWithProvider(rName, "alternate", acctest.ProviderNameAlternate, 1),
+		fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  provider = aws
+
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = "%[1]s-%[2]s"
+
+  depends_on = [aws_elasticache_replication_group.primary]
+}
+
+resource "aws_elasticache_replication_group" "primary" {
+  provider = aws
+
+  replication_group_id          = "%[1]s-p"
+  replication_group_description = "primary"
+
+  subnet_group_name = aws_elasticache_subnet_group.primary.name
+
+  node_type = "cache.m5.large"
+
+  engine                = "redis"
+  engine_version        = "5.0.6"
+  number_cache_clusters = 1
+
+  lifecycle {
+    ignore_changes = [global_replication_group_id]
+  }
+}
+
+resource "aws_elasticache_replication_group" "alternate" {
+  provider = awsalternate
+
+  replication_group_id          = "%[1]s-a"
+  replication_group_description = "alternate"
+  global_replication_group_id   = aws_elasticache_global_replication_group.test.global_replication_group_id
+
+  subnet_group_name = aws_elasticache_subnet_group.alternate.name
+
+  number_cache_clusters = 1
+}
+`, rName, primarySuffix))
+}
+
 func testAccReplicationGroupConfig_ReplaceSecondary_DifferentRegion_Setup(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
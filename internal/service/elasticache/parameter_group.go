@@ -2,17 +2,27 @@ package elasticache
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -20,23 +30,87 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// We can only modify 20 parameters at a time.
+const maxParameterGroupParametersPerRequest = 20
+
+// parameterGroupDefaultDescription is used when neither the resource nor the provider's
+// elasticache_parameter_group_default_description configures a description.
+const parameterGroupDefaultDescription = "Managed by Terraform"
+
+const (
+	errCodeThrottling           = "Throttling"
+	errCodeRequestLimitExceeded = "RequestLimitExceeded"
+
+	parameterGroupThrottlingTimeout = 2 * time.Minute
+)
+
+// parameterMinimumFamilies maps parameter names to the earliest parameter group
+// family that supports them. Parameters configured for an older family are
+// skipped instead of failing the whole modify request.
+var parameterMinimumFamilies = map[string]string{
+	"reserved-memory-percent": "redis3.2",
+}
+
+const (
+	parameterSourceAll           = "all"
+	parameterSourceEngineDefault = "engine-default"
+	parameterSourceSystem        = "system"
+	parameterSourceUser          = "user"
+)
+
+func parameterSource_Values() []string {
+	return []string{
+		parameterSourceAll,
+		parameterSourceEngineDefault,
+		parameterSourceSystem,
+		parameterSourceUser,
+	}
+}
+
+func engineDefaultParameterSource_Values() []string {
+	return []string{
+		parameterSourceEngineDefault,
+		parameterSourceSystem,
+		parameterSourceUser,
+	}
+}
+
+const (
+	parameterChangeModify = "modify"
+	parameterChangeReset  = "reset"
+)
+
 func ResourceParameterGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceParameterGroupCreate,
-		Read:   resourceParameterGroupRead,
-		Update: resourceParameterGroupUpdate,
-		Delete: resourceParameterGroupDelete,
+		CreateContext: resourceParameterGroupCreate,
+		ReadContext:   resourceParameterGroupRead,
+		UpdateContext: resourceParameterGroupUpdate,
+		DeleteContext: resourceParameterGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceParameterGroupImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
-				Required: true,
 				StateFunc: func(val interface{}) string {
 					return strings.ToLower(val.(string))
 				},
+				ValidateFunc:  validParameterGroupName,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc:  validParameterGroupNamePrefix,
+				ConflictsWith: []string{"name"},
 			},
 			"family": {
 				Type:     schema.TypeString,
@@ -46,25 +120,96 @@ func ResourceParameterGroup() *schema.Resource {
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
-				Default:  "Managed by Terraform",
+			},
+			"allowed_parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validParameterName,
+				},
 			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ignore_parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validParameterName,
+				},
+			},
+			"include_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      parameterSourceUser,
+				ValidateFunc: validation.StringInSlice(parameterSource_Values(), false),
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_parameters_per_request": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      maxParameterGroupParametersPerRequest,
+				ValidateFunc: validation.IntBetween(1, maxParameterGroupParametersPerRequest),
+			},
 			"parameter": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validParameterName,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validParameterValue,
+						},
+					},
+				},
+				Set: ParameterHash,
+			},
+			"parameters_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pending_changes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"reboot_clusters_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"source_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"copied_parameter": {
+				Type:     schema.TypeSet,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Computed: true,
 						},
 						"value": {
 							Type:     schema.TypeString,
-							Required: true,
+							Computed: true,
 						},
 					},
 				},
@@ -73,115 +218,352 @@ func ResourceParameterGroup() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceParameterGroupCustomizeDiffDescription,
+			resourceParameterGroupCustomizeDiffParameters,
+			resourceParameterGroupCustomizeDiffAllowedParameters,
+			resourceParameterGroupCustomizeDiffValidateParameters,
+			customdiff.ComputedIf("last_modified", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("parameter")
+			}),
+			customdiff.ComputedIf("parameters_json", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("ignore_parameters") || diff.HasChange("parameter")
+			}),
+			// pending_changes is cleared on refresh and only recorded by applies that change parameters.
+			customdiff.ComputedIf("pending_changes", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("parameter")
+			}),
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+// resourceParameterGroupCustomizeDiffDescription plans the default description when a parameter group
+// is created without one. Existing parameter groups keep their description when it is not configured,
+// as ElastiCache cannot modify it and a changed default would otherwise replace them.
+func resourceParameterGroupCustomizeDiffDescription(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	if config := diff.GetRawConfig(); config.IsNull() || !config.GetAttr("description").IsNull() {
+		return nil
+	}
+
+	description := parameterGroupDefaultDescription
+	if v := meta.(*conns.AWSClient).ElastiCacheDefaultDescription; v != "" {
+		description = v
+	}
+
+	if diff.Get("description").(string) == description {
+		return nil
+	}
+
+	return diff.SetNew("description", description)
+}
+
+// resourceParameterGroupCustomizeDiffParameters plans parameter as the configured parameter blocks merged
+// with the parameters copied from source_parameter_group_name, so that copied parameters are tracked without
+// being configured inline. The copied parameters are only known once a parameter group is created.
+func resourceParameterGroupCustomizeDiffParameters(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk("source_parameter_group_name"); ok {
+		if diff.Id() == "" || diff.HasChange("source_parameter_group_name") || diff.HasChange("family") || diff.HasChange("name") {
+			return diff.SetNewComputed("parameter")
+		}
+	}
+
+	configured, ok := configuredParameters(diff.GetRawConfig())
+
+	if !ok || !diff.NewValueKnown("ignore_parameters") {
+		return nil
+	}
+
+	return diff.SetNew("parameter", MergeCopiedParameters(configured, diff.Get("copied_parameter").(*schema.Set), diff.Get("ignore_parameters").(*schema.Set)))
+}
+
+// resourceDiffConfiguredParameters returns the configured parameter blocks, without the copied parameters
+// planned by resourceParameterGroupCustomizeDiffParameters.
+func resourceDiffConfiguredParameters(diff *schema.ResourceDiff) *schema.Set {
+	if configured, ok := configuredParameters(diff.GetRawConfig()); ok {
+		return configured
 	}
+
+	return diff.Get("parameter").(*schema.Set)
 }
 
-func resourceParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupCustomizeDiffAllowedParameters(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	allowed := diff.Get("allowed_parameters").(*schema.Set)
+
+	if allowed.Len() == 0 {
+		return nil
+	}
+
+	if disallowed := DisallowedParameters(resourceDiffConfiguredParameters(diff), allowed); len(disallowed) > 0 {
+		return fmt.Errorf("parameters not in allowed_parameters: %s", strings.Join(disallowed, ", "))
+	}
+
+	return nil
+}
+
+// resourceParameterGroupCustomizeDiffValidateParameters checks the configured parameters against the
+// engine defaults of the family when enabled by the provider's elasticache_validate_parameters flag.
+func resourceParameterGroupCustomizeDiffValidateParameters(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !meta.(*conns.AWSClient).ElastiCacheValidateParameters {
+		return nil
+	}
+
+	if !diff.HasChange("parameter") && !diff.HasChange("family") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("family") {
+		return nil
+	}
+
+	family := diff.Get("family").(string)
+	configured := resourceDiffConfiguredParameters(diff)
+
+	if family == "" || configured.Len() == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	defaults, err := FindEngineDefaultParametersByFamily(conn, family)
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache engine default parameters (%s): %w", family, err)
+	}
+
+	if invalid := InvalidParameters(configured, family, defaults); len(invalid) > 0 {
+		return fmt.Errorf("invalid parameters for family %s: %s", family, strings.Join(invalid, "; "))
+	}
+
+	return nil
+}
+
+func resourceParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 	createOpts := elasticache.CreateCacheParameterGroupInput{
-		CacheParameterGroupName:   aws.String(d.Get("name").(string)),
+		CacheParameterGroupName:   aws.String(name),
 		CacheParameterGroupFamily: aws.String(d.Get("family").(string)),
 		Description:               aws.String(d.Get("description").(string)),
 		Tags:                      Tags(tags.IgnoreAWS()),
 	}
 
 	log.Printf("[DEBUG] Create ElastiCache Parameter Group: %#v", createOpts)
-	resp, err := conn.CreateCacheParameterGroup(&createOpts)
+	outputRaw, err := retryWhenParameterGroupThrottled(func() (interface{}, error) {
+		return conn.CreateCacheParameterGroup(&createOpts)
+	})
 	if err != nil {
-		return fmt.Errorf("error creating ElastiCache Parameter Group: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating ElastiCache Parameter Group: %w", err))
 	}
 
+	resp := outputRaw.(*elasticache.CreateCacheParameterGroupOutput)
+
 	d.SetId(aws.StringValue(resp.CacheParameterGroup.CacheParameterGroupName))
 	d.Set("arn", resp.CacheParameterGroup.ARN)
 	log.Printf("[INFO] ElastiCache Parameter Group ID: %s", d.Id())
 
-	return resourceParameterGroupUpdate(d, meta)
+	// From here on the parameter group exists, so errors are returned with the ID
+	// kept in state rather than orphaning the parameter group.
+	maxParams := d.Get("max_parameters_per_request").(int)
+
+	// The planned parameter attribute is unknown when parameters are copied, so use the configuration.
+	configured, ok := configuredParameters(d.GetRawConfig())
+	if !ok {
+		configured = d.Get("parameter").(*schema.Set)
+	}
+
+	ignore := d.Get("ignore_parameters").(*schema.Set)
+	copied := schema.NewSet(ParameterHash, nil)
+
+	if v, ok := d.GetOk("source_parameter_group_name"); ok {
+		sourceParameters, err := FindParameterGroupUserParametersByName(conn, v.(string))
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading ElastiCache Parameter Group (%s) source parameters: %w", v.(string), err))
+		}
+
+		// Inline parameter blocks take precedence over copied values, and ignored parameters are not copied.
+		for _, p := range ExcludeIgnoredParameters(SourceParametersToCopy(sourceParameters, configured), ignore) {
+			copied.Add(map[string]interface{}{
+				"name":  aws.StringValue(p.ParameterName),
+				"value": aws.StringValue(p.ParameterValue),
+			})
+		}
+
+		d.Set("copied_parameter", copied.List())
+	}
+
+	var diags diag.Diagnostics
+	pendingChanges := make(map[string]string)
+	planned := MergeCopiedParameters(configured, copied, ignore)
+
+	if len(planned) > 0 {
+		family := d.Get("family").(string)
+		parameters, unsupported := excludeUnsupportedParameters(family, ExpandParameters(planned))
+
+		if len(unsupported) > 0 {
+			diags = append(diags, unsupportedParametersDiagnostic(family, unsupported))
+		}
+
+		if err := modifyParameterGroupParameters(conn, d.Id(), parameters, maxParams); err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("error modifying ElastiCache Parameter Group (%s): %w", d.Id(), err))...)
+		}
+
+		for _, param := range parameters {
+			pendingChanges[aws.StringValue(param.ParameterName)] = parameterChangeModify
+		}
+	}
+
+	// Read keeps the skipped parameters that the family does not support.
+	d.Set("parameter", planned)
+
+	// ElastiCache does not return a modification time, so last_modified records when
+	// the provider last applied parameter changes.
+	if len(pendingChanges) > 0 {
+		d.Set("last_modified", time.Now().UTC().Format(time.RFC3339))
+	}
+
+	diags = append(diags, resourceParameterGroupRead(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("pending_changes", pendingChanges)
+
+	return diags
 }
 
-func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	describeOpts := elasticache.DescribeCacheParameterGroupsInput{
-		CacheParameterGroupName: aws.String(d.Id()),
+	parameterGroup, err := FindParameterGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	describeResp, err := conn.DescribeCacheParameterGroups(&describeOpts)
 	if err != nil {
-		return err
+		return diag.FromErr(fmt.Errorf("error reading ElastiCache Parameter Group (%s): %w", d.Id(), err))
 	}
 
-	if len(describeResp.CacheParameterGroups) != 1 ||
-		aws.StringValue(describeResp.CacheParameterGroups[0].CacheParameterGroupName) != d.Id() {
-		return fmt.Errorf("unable to find Parameter Group: %#v", describeResp.CacheParameterGroups)
+	// Attributes missing from a partial response keep their current values.
+	attributes, missing := FlattenParameterGroupAttributes(parameterGroup)
+
+	for _, k := range missing {
+		log.Printf("[WARN] ElastiCache Parameter Group (%s) %s missing from response, keeping current value", d.Id(), k)
 	}
 
-	d.Set("name", describeResp.CacheParameterGroups[0].CacheParameterGroupName)
-	d.Set("family", describeResp.CacheParameterGroups[0].CacheParameterGroupFamily)
-	d.Set("description", describeResp.CacheParameterGroups[0].Description)
-	d.Set("arn", describeResp.CacheParameterGroups[0].ARN)
+	for k, v := range attributes {
+		d.Set(k, v)
+	}
 
-	tags, err := ListTags(conn, aws.StringValue(describeResp.CacheParameterGroups[0].ARN))
+	tags, err := ListTags(conn, d.Get("arn").(string))
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for ElastiCache Parameter Group (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for ElastiCache Parameter Group (%s): %w", d.Id(), err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
-	// Only include user customized parameters as there's hundreds of system/default ones
-	describeParametersOpts := elasticache.DescribeCacheParametersInput{
-		CacheParameterGroupName: aws.String(d.Id()),
-		Source:                  aws.String("user"),
+	// By default only include user customized parameters as there's hundreds of system/default ones
+	source := d.Get("include_source").(string)
+	if source == parameterSourceAll {
+		source = ""
+	}
+
+	parameters, err := FindParameterGroupParametersByNameAndSource(conn, d.Id(), source)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading ElastiCache Parameter Group (%s) parameters: %w", d.Id(), err))
 	}
 
-	describeParametersResp, err := conn.DescribeCacheParameters(&describeParametersOpts)
+	flattenedParameters := FlattenParameters(parameters, d.Get("ignore_parameters").(*schema.Set))
+
+	// Parameters that the family does not support are skipped with a warning when applied. They are kept as
+	// configured so that the plan converges.
+	d.Set("parameter", append(flattenedParameters, unsupportedParameters(d.Get("family").(string), d.Get("parameter").(*schema.Set))...))
+
+	parametersJSON, err := ParametersJSON(flattenedParameters)
+
 	if err != nil {
-		return err
+		return diag.FromErr(fmt.Errorf("error marshaling ElastiCache Parameter Group (%s) parameters to JSON: %w", d.Id(), err))
 	}
 
-	d.Set("parameter", FlattenParameters(describeParametersResp.Parameters))
+	d.Set("parameters_json", parametersJSON)
+
+	// Pending changes only describe the apply that made them.
+	d.Set("pending_changes", map[string]string{})
 
 	return nil
 }
 
-func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating ElastiCache Parameter Group (%s) tags: %w", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("error updating ElastiCache Parameter Group (%s) tags: %w", d.Get("arn").(string), err))
 		}
 	}
 
+	var diags diag.Diagnostics
+	pendingChanges := make(map[string]string)
+
 	if d.HasChange("parameter") {
 		o, n := d.GetChange("parameter")
 		toRemove, toAdd := ParameterChanges(o, n)
 
-		log.Printf("[DEBUG] Parameters to remove: %#v", toRemove)
-		log.Printf("[DEBUG] Parameters to add or update: %#v", toAdd)
+		// Parameters managed outside of Terraform are neither set nor reset.
+		ignoreParameters := d.Get("ignore_parameters").(*schema.Set)
+		toRemove = ExcludeIgnoredParameters(toRemove, ignoreParameters)
+		toAdd = ExcludeIgnoredParameters(toAdd, ignoreParameters)
 
-		// We can only modify 20 parameters at a time, so walk them until
-		// we've got them all.
-		const maxParams = 20
+		// Parameters that the family does not support were never set, so they are not reset either.
+		family := d.Get("family").(string)
+		toRemove, _ = excludeUnsupportedParameters(family, toRemove)
+		toAdd, unsupported := excludeUnsupportedParameters(family, toAdd)
+
+		if len(unsupported) > 0 {
+			diags = append(diags, unsupportedParametersDiagnostic(family, unsupported))
+		}
 
-		for len(toRemove) > 0 {
+		logParameterChanges(d.Id(), o.(*schema.Set), toRemove, toAdd)
+
+		for _, param := range toRemove {
+			pendingChanges[aws.StringValue(param.ParameterName)] = parameterChangeReset
+		}
+		for _, param := range toAdd {
+			pendingChanges[aws.StringValue(param.ParameterName)] = parameterChangeModify
+		}
+
+		maxParams := d.Get("max_parameters_per_request").(int)
+
+		resetBatchCount := parameterBatchCount(len(toRemove), maxParams)
+
+		for batch := 1; len(toRemove) > 0; batch++ {
 			var paramsToModify []*elasticache.ParameterNameValue
 			if len(toRemove) <= maxParams {
 				paramsToModify, toRemove = toRemove[:], nil
@@ -189,7 +571,10 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 				paramsToModify, toRemove = toRemove[:maxParams], toRemove[maxParams:]
 			}
 
-			err := resourceResetParameterGroup(conn, d.Get("name").(string), paramsToModify)
+			log.Printf("[DEBUG] Resetting ElastiCache Parameter Group (%s) parameters, batch %d/%d (%d parameters)", d.Id(), batch, resetBatchCount, len(paramsToModify))
+			start := time.Now()
+
+			err := resourceResetParameterGroup(conn, d.Id(), paramsToModify)
 
 			// When attempting to reset the reserved-memory parameter, the API
 			// can return two types of error.
@@ -205,7 +590,8 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 			// above, which may become out of date, here we add logic to
 			// workaround this API behavior
 
-			if tfresource.TimedOut(err) || tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidParameterValueException, "Parameter reserved-memory doesn't exist") {
+			// The reserved-memory parameters only exist in Redis families.
+			if ReservedMemoryWorkaroundApplies(d.Get("family").(string)) && (tfresource.TimedOut(err) || tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidParameterValueException, "Parameter reserved-memory doesn't exist")) {
 				for i, paramToModify := range paramsToModify {
 					if aws.StringValue(paramToModify.ParameterName) != "reserved-memory" {
 						continue
@@ -232,9 +618,8 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 						break
 					}
 
-					// The reserved-memory-percent parameter does not exist in redis2.6 and redis2.8
 					family := d.Get("family").(string)
-					if family == "redis2.6" || family == "redis2.8" {
+					if !ParameterSupportedByFamily("reserved-memory-percent", family) {
 						log.Printf("[WARN] Cannot reset ElastiCache Parameter Group (%s) reserved-memory parameter with %s family", d.Id(), family)
 						break
 					}
@@ -245,13 +630,13 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 							ParameterValue: aws.String("0"),
 						},
 					}
-					err = resourceModifyParameterGroup(conn, d.Get("name").(string), paramsToModify)
+					err = resourceModifyParameterGroup(conn, d.Id(), paramsToModify)
 					if err != nil {
 						log.Printf("[WARN] Error attempting reserved-memory workaround to switch to reserved-memory-percent: %s", err)
 						break
 					}
 
-					err = resourceResetParameterGroup(conn, d.Get("name").(string), workaroundParams)
+					err = resourceResetParameterGroup(conn, d.Id(), workaroundParams)
 					if err != nil {
 						log.Printf("[WARN] Error attempting reserved-memory workaround to reset reserved-memory-percent: %s", err)
 					}
@@ -261,34 +646,49 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 
 				// Retry any remaining parameter resets with reserved-memory potentially removed
 				if len(paramsToModify) > 0 {
-					err = resourceResetParameterGroup(conn, d.Get("name").(string), paramsToModify)
+					err = resourceResetParameterGroup(conn, d.Id(), paramsToModify)
 				}
 			}
 
 			if err != nil {
-				return fmt.Errorf("error resetting ElastiCache Parameter Group: %w", err)
+				return append(diags, diag.FromErr(fmt.Errorf("error resetting ElastiCache Parameter Group: %w", err))...)
 			}
+
+			log.Printf("[DEBUG] Reset ElastiCache Parameter Group (%s) parameters, batch %d/%d in %s", d.Id(), batch, resetBatchCount, time.Since(start))
 		}
 
-		for len(toAdd) > 0 {
-			var paramsToModify []*elasticache.ParameterNameValue
-			if len(toAdd) <= maxParams {
-				paramsToModify, toAdd = toAdd[:], nil
-			} else {
-				paramsToModify, toAdd = toAdd[:maxParams], toAdd[maxParams:]
+		if err := modifyParameterGroupParameters(conn, d.Id(), toAdd, maxParams); err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("error modifying ElastiCache Parameter Group: %w", err))...)
+		}
+
+		if len(pendingChanges) > 0 {
+			d.Set("last_modified", time.Now().UTC().Format(time.RFC3339))
+		}
+
+		if d.Get("reboot_clusters_on_change").(bool) {
+			changedNames := make([]string, 0, len(pendingChanges))
+			for name := range pendingChanges {
+				changedNames = append(changedNames, name)
 			}
 
-			err := resourceModifyParameterGroup(conn, d.Get("name").(string), paramsToModify)
-			if err != nil {
-				return fmt.Errorf("error modifying ElastiCache Parameter Group: %w", err)
+			if err := rebootParameterGroupClusters(conn, d.Id(), changedNames); err != nil {
+				return append(diags, diag.FromErr(fmt.Errorf("error rebooting ElastiCache Parameter Group (%s) cache clusters: %w", d.Id(), err))...)
 			}
 		}
 	}
 
-	return resourceParameterGroupRead(d, meta)
+	diags = append(diags, resourceParameterGroupRead(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("pending_changes", pendingChanges)
+
+	return diags
 }
 
-func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
 	deleteOpts := elasticache.DeleteCacheParameterGroupInput{
@@ -302,6 +702,11 @@ func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) erro
 				return nil
 			}
 			if ok && awsErr.Code() == "InvalidCacheParameterGroupState" {
+				// Waiting does not help while cache clusters still use the parameter group,
+				// which commonly happens when a change to name or family forces a replacement.
+				if err := parameterGroupInUseError(conn, d.Id(), err); err != nil {
+					return resource.NonRetryableError(err)
+				}
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -316,12 +721,75 @@ func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting ElastiCache Parameter Group (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting ElastiCache Parameter Group (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
+// parameterGroupInUseError returns an error naming the cache clusters that still use the
+// parameter group, or nil if there are none.
+func parameterGroupInUseError(conn *elasticache.ElastiCache, name string, err error) error {
+	clusters, findErr := FindCacheClustersByParameterGroupName(conn, name)
+
+	if findErr != nil || len(clusters) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		ids = append(ids, aws.StringValue(cluster.CacheClusterId))
+	}
+
+	return fmt.Errorf("still in use by cache clusters (%s). Reassign the clusters to another parameter group, "+
+		"or set the create_before_destroy lifecycle argument so the replacement parameter group is created and "+
+		"assigned first: %w", strings.Join(ids, ", "), err)
+}
+
+func resourceParameterGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, err := ParameterGroupNameFromImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(name)
+	d.Set("include_source", parameterSourceUser)
+	d.Set("max_parameters_per_request", maxParameterGroupParametersPerRequest)
+	d.Set("reboot_clusters_on_change", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// ParameterGroupNameFromImportID returns the parameter group name from an import ID
+// that is either the name itself or the ARN of the parameter group.
+func ParameterGroupNameFromImportID(id string) (string, error) {
+	if !arn.IsARN(id) {
+		return id, nil
+	}
+
+	idErr := fmt.Errorf("expected ID in format of NAME or arn:PARTITION:elasticache:REGION:ACCOUNTID:parametergroup:NAME and provided: %s", id)
+
+	parsedARN, err := arn.Parse(id)
+
+	if err != nil {
+		return "", idErr
+	}
+
+	if parsedARN.Service != elasticache.ServiceName {
+		return "", idErr
+	}
+
+	// The resource is documented as "parametergroup:NAME", but accept "parametergroup/NAME" too.
+	parts := strings.FieldsFunc(parsedARN.Resource, func(r rune) bool { return r == ':' || r == '/' })
+
+	if len(parts) != 2 || parts[0] != "parametergroup" {
+		return "", idErr
+	}
+
+	return parts[1], nil
+}
+
 func ParameterHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -373,6 +841,211 @@ func ParameterChanges(o, n interface{}) (remove, addOrUpdate []*elasticache.Para
 	return remove, addOrUpdate
 }
 
+// rebootParameterGroupClusters reboots the cache clusters using a parameter group and waits
+// for them to become available when any of the changed parameters requires a reboot.
+func rebootParameterGroupClusters(conn *elasticache.ElastiCache, name string, changedNames []string) error {
+	parameters, err := FindParameterGroupParametersByNameAndSource(conn, name, "")
+
+	if err != nil {
+		return fmt.Errorf("error reading parameters: %w", err)
+	}
+
+	if !ParametersRequireReboot(parameters, changedNames) {
+		return nil
+	}
+
+	clusters, err := FindCacheClustersByParameterGroupName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading cache clusters: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		id := aws.StringValue(cluster.CacheClusterId)
+
+		input := &elasticache.RebootCacheClusterInput{
+			CacheClusterId: cluster.CacheClusterId,
+		}
+
+		for _, node := range cluster.CacheNodes {
+			input.CacheNodeIdsToReboot = append(input.CacheNodeIdsToReboot, node.CacheNodeId)
+		}
+
+		log.Printf("[DEBUG] Rebooting ElastiCache Cache Cluster: %s", input)
+		if _, err := conn.RebootCacheCluster(input); err != nil {
+			return fmt.Errorf("error rebooting ElastiCache Cache Cluster (%s): %w", id, err)
+		}
+	}
+
+	for _, cluster := range clusters {
+		id := aws.StringValue(cluster.CacheClusterId)
+
+		if _, err := waitCacheClusterAvailable(conn, id, CacheClusterUpdatedTimeout); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache Cache Cluster (%s) reboot: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// ParametersRequireReboot returns whether any of the named parameters requires
+// a reboot of the cache nodes to take effect.
+func ParametersRequireReboot(parameters []*elasticache.Parameter, names []string) bool {
+	changeTypes := make(map[string]string, len(parameters))
+	for _, p := range parameters {
+		if p == nil {
+			continue
+		}
+
+		changeTypes[strings.ToLower(aws.StringValue(p.ParameterName))] = aws.StringValue(p.ChangeType)
+	}
+
+	for _, name := range names {
+		if changeTypes[strings.ToLower(name)] == elasticache.ChangeTypeRequiresReboot {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReservedMemoryWorkaroundApplies returns whether the reserved-memory reset
+// workaround can apply to a parameter group family.
+func ReservedMemoryWorkaroundApplies(family string) bool {
+	return !strings.HasPrefix(family, "memcached")
+}
+
+// ParameterSupportedByFamily returns whether a parameter is available in a
+// parameter group family, e.g. "redis3.2", according to parameterMinimumFamilies.
+// Parameters without a known minimum family are assumed to be supported.
+func ParameterSupportedByFamily(name, family string) bool {
+	minimum, ok := parameterMinimumFamilies[strings.ToLower(name)]
+
+	if !ok {
+		return true
+	}
+
+	engine, version := splitParameterGroupFamily(family)
+	minimumEngine, minimumVersion := splitParameterGroupFamily(minimum)
+
+	if engine != minimumEngine {
+		return false
+	}
+
+	return compareParameterGroupFamilyVersions(version, minimumVersion) >= 0
+}
+
+// splitParameterGroupFamily splits a family such as "redis6.x" into its engine and version.
+func splitParameterGroupFamily(family string) (string, string) {
+	i := strings.IndexAny(family, "0123456789")
+
+	if i == -1 {
+		return family, ""
+	}
+
+	return family[:i], family[i:]
+}
+
+// compareParameterGroupFamilyVersions compares dotted family versions.
+// Non-numeric components such as the "x" in "6.x" compare as 0.
+func compareParameterGroupFamilyVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// excludeUnsupportedParameters removes parameters that are not available in the family, returning the
+// sorted names of the removed parameters.
+func excludeUnsupportedParameters(family string, parameters []*elasticache.ParameterNameValue) ([]*elasticache.ParameterNameValue, []string) {
+	result := make([]*elasticache.ParameterNameValue, 0, len(parameters))
+	var unsupported []string
+
+	for _, parameter := range parameters {
+		name := aws.StringValue(parameter.ParameterName)
+
+		if !ParameterSupportedByFamily(name, family) {
+			unsupported = append(unsupported, name)
+			continue
+		}
+
+		result = append(result, parameter)
+	}
+
+	sort.Strings(unsupported)
+
+	return result, unsupported
+}
+
+// unsupportedParameters returns the parameters in the set that are not available in the family.
+func unsupportedParameters(family string, parameters *schema.Set) []map[string]interface{} {
+	var result []map[string]interface{}
+
+	for _, raw := range parameters.List() {
+		tfMap := raw.(map[string]interface{})
+
+		if !ParameterSupportedByFamily(tfMap["name"].(string), family) {
+			result = append(result, tfMap)
+		}
+	}
+
+	return result
+}
+
+func unsupportedParametersDiagnostic(family string, names []string) diag.Diagnostic {
+	minimums := make([]string, 0, len(names))
+	for _, name := range names {
+		minimums = append(minimums, fmt.Sprintf("%s (requires %s or later)", name, parameterMinimumFamilies[strings.ToLower(name)]))
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("ElastiCache parameters not supported by the %s family were skipped", family),
+		Detail: fmt.Sprintf("The following parameters are not available in the %s parameter group family and were not applied: %s. "+
+			"Remove them from the configuration, or use a family that supports them.", family, strings.Join(minimums, ", ")),
+	}
+}
+
+// logParameterChanges logs the name and old and new value of each changed parameter.
+func logParameterChanges(groupName string, o *schema.Set, remove, addOrUpdate []*elasticache.ParameterNameValue) {
+	oldValues := make(map[string]string, o.Len())
+	for _, raw := range o.List() {
+		param := raw.(map[string]interface{})
+		oldValues[param["name"].(string)] = param["value"].(string)
+	}
+
+	for _, param := range remove {
+		log.Printf("[DEBUG] ElastiCache Parameter Group %s: resetting %s (%s -> default)", groupName, aws.StringValue(param.ParameterName), aws.StringValue(param.ParameterValue))
+	}
+
+	for _, param := range addOrUpdate {
+		name, value := aws.StringValue(param.ParameterName), aws.StringValue(param.ParameterValue)
+
+		if oldValue, ok := oldValues[name]; ok {
+			log.Printf("[DEBUG] ElastiCache Parameter Group %s: modifying %s (%s -> %s)", groupName, name, oldValue, value)
+		} else {
+			log.Printf("[DEBUG] ElastiCache Parameter Group %s: setting %s (%s)", groupName, name, value)
+		}
+	}
+}
+
 func resourceResetParameterGroup(conn *elasticache.ElastiCache, name string, parameters []*elasticache.ParameterNameValue) error {
 	input := elasticache.ResetCacheParameterGroupInput{
 		CacheParameterGroupName: aws.String(name),
@@ -384,28 +1057,212 @@ func resourceResetParameterGroup(conn *elasticache.ElastiCache, name string, par
 			if tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidCacheParameterGroupStateFault, " has pending changes") {
 				return resource.RetryableError(err)
 			}
+			if tfawserr.ErrCodeEquals(err, errCodeThrottling, errCodeRequestLimitExceeded) {
+				return resource.RetryableError(err)
+			}
 			return resource.NonRetryableError(err)
 		}
 		return nil
 	})
 }
 
+// modifyParameterGroupParameters applies parameters in batches of at most maxParams.
+func modifyParameterGroupParameters(conn *elasticache.ElastiCache, name string, parameters []*elasticache.ParameterNameValue, maxParams int) error {
+	batchCount := parameterBatchCount(len(parameters), maxParams)
+
+	for batch := 1; len(parameters) > 0; batch++ {
+		var paramsToModify []*elasticache.ParameterNameValue
+		if len(parameters) <= maxParams {
+			paramsToModify, parameters = parameters[:], nil
+		} else {
+			paramsToModify, parameters = parameters[:maxParams], parameters[maxParams:]
+		}
+
+		log.Printf("[DEBUG] Modifying ElastiCache Parameter Group (%s) parameters, batch %d/%d (%d parameters)", name, batch, batchCount, len(paramsToModify))
+		start := time.Now()
+
+		if err := resourceModifyParameterGroup(conn, name, paramsToModify); err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Modified ElastiCache Parameter Group (%s) parameters, batch %d/%d in %s", name, batch, batchCount, time.Since(start))
+	}
+
+	return nil
+}
+
+// parameterBatchCount returns the number of requests needed to send n parameters in batches of maxParams.
+func parameterBatchCount(n, maxParams int) int {
+	return (n + maxParams - 1) / maxParams
+}
+
 func resourceModifyParameterGroup(conn *elasticache.ElastiCache, name string, parameters []*elasticache.ParameterNameValue) error {
 	input := elasticache.ModifyCacheParameterGroupInput{
 		CacheParameterGroupName: aws.String(name),
 		ParameterNameValues:     parameters,
 	}
-	_, err := conn.ModifyCacheParameterGroup(&input)
+	_, err := retryWhenParameterGroupThrottled(func() (interface{}, error) {
+		return conn.ModifyCacheParameterGroup(&input)
+	})
 	return err
 }
 
-// Flattens an array of Parameters into a []map[string]interface{}
-func FlattenParameters(list []*elasticache.Parameter) []map[string]interface{} {
+// retryWhenParameterGroupThrottled retries f while ElastiCache throttles the request.
+func retryWhenParameterGroupThrottled(f func() (interface{}, error)) (interface{}, error) {
+	return tfresource.RetryWhenAWSErrCodeEquals(parameterGroupThrottlingTimeout, f, errCodeThrottling, errCodeRequestLimitExceeded)
+}
+
+// SourceParametersToCopy returns the source group's parameters that are not
+// configured inline, as inline parameters win on conflict.
+func SourceParametersToCopy(sourceParameters []*elasticache.Parameter, configured *schema.Set) []*elasticache.ParameterNameValue {
+	configuredNames := make(map[string]struct{}, configured.Len())
+	for _, raw := range configured.List() {
+		configuredNames[strings.ToLower(raw.(map[string]interface{})["name"].(string))] = struct{}{}
+	}
+
+	result := make([]*elasticache.ParameterNameValue, 0, len(sourceParameters))
+	for _, p := range sourceParameters {
+		if p == nil || p.ParameterValue == nil {
+			continue
+		}
+
+		if _, ok := configuredNames[strings.ToLower(aws.StringValue(p.ParameterName))]; ok {
+			continue
+		}
+
+		result = append(result, &elasticache.ParameterNameValue{
+			ParameterName:  p.ParameterName,
+			ParameterValue: p.ParameterValue,
+		})
+	}
+
+	return result
+}
+
+// MergeCopiedParameters returns the parameters tracked in state: the configured parameter blocks and the
+// parameters copied from the source parameter group that are not configured inline, as inline parameter
+// blocks win on conflict. Parameters named in ignore are not tracked.
+func MergeCopiedParameters(configured, copied, ignore *schema.Set) []interface{} {
+	configuredNames := make(map[string]struct{}, configured.Len())
+	for _, raw := range configured.List() {
+		configuredNames[strings.ToLower(raw.(map[string]interface{})["name"].(string))] = struct{}{}
+	}
+
+	ignoreNames := ignoredParameterNames(ignore)
+
+	result := make([]interface{}, 0, configured.Len()+copied.Len())
+	for _, raw := range configured.List() {
+		if _, ok := ignoreNames[strings.ToLower(raw.(map[string]interface{})["name"].(string))]; ok {
+			continue
+		}
+
+		result = append(result, raw)
+	}
+
+	for _, raw := range copied.List() {
+		name := strings.ToLower(raw.(map[string]interface{})["name"].(string))
+
+		if _, ok := configuredNames[name]; ok {
+			continue
+		}
+
+		if _, ok := ignoreNames[name]; ok {
+			continue
+		}
+
+		result = append(result, raw)
+	}
+
+	return result
+}
+
+// configuredParameters returns the parameter blocks in the configuration, and whether they are known.
+func configuredParameters(config cty.Value) (*schema.Set, bool) {
+	configured := schema.NewSet(ParameterHash, nil)
+
+	if config.IsNull() || !config.IsKnown() {
+		return configured, false
+	}
+
+	v := config.GetAttr("parameter")
+
+	if !v.IsWhollyKnown() {
+		return configured, false
+	}
+
+	if v.IsNull() {
+		return configured, true
+	}
+
+	for it := v.ElementIterator(); it.Next(); {
+		_, tfObj := it.Element()
+		name, value := tfObj.GetAttr("name"), tfObj.GetAttr("value")
+
+		if name.IsNull() || value.IsNull() {
+			continue
+		}
+
+		configured.Add(map[string]interface{}{
+			"name":  name.AsString(),
+			"value": value.AsString(),
+		})
+	}
+
+	return configured, true
+}
+
+// ExcludeIgnoredParameters removes the parameters named in ignore. Names are compared
+// case-insensitively, as parameter names are stored in lowercase.
+func ExcludeIgnoredParameters(parameters []*elasticache.ParameterNameValue, ignore *schema.Set) []*elasticache.ParameterNameValue {
+	if ignore == nil || ignore.Len() == 0 {
+		return parameters
+	}
+
+	ignoreNames := ignoredParameterNames(ignore)
+
+	result := make([]*elasticache.ParameterNameValue, 0, len(parameters))
+	for _, p := range parameters {
+		if _, ok := ignoreNames[strings.ToLower(aws.StringValue(p.ParameterName))]; ok {
+			log.Printf("[DEBUG] Ignoring ElastiCache parameter %s", aws.StringValue(p.ParameterName))
+			continue
+		}
+
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// ignoredParameterNames returns the lowercased names in ignore.
+func ignoredParameterNames(ignore *schema.Set) map[string]struct{} {
+	if ignore == nil {
+		return nil
+	}
+
+	names := make(map[string]struct{}, ignore.Len())
+	for _, v := range ignore.List() {
+		names[strings.ToLower(v.(string))] = struct{}{}
+	}
+
+	return names
+}
+
+// Flattens an array of Parameters into a []map[string]interface{},
+// omitting any parameters whose names are in ignore
+func FlattenParameters(list []*elasticache.Parameter, ignore *schema.Set) []map[string]interface{} {
+	ignoreNames := ignoredParameterNames(ignore)
+
 	result := make([]map[string]interface{}, 0, len(list))
 	for _, i := range list {
+		name := strings.ToLower(aws.StringValue(i.ParameterName))
+
+		if _, ok := ignoreNames[name]; ok {
+			continue
+		}
+
 		if i.ParameterValue != nil {
 			result = append(result, map[string]interface{}{
-				"name":  strings.ToLower(aws.StringValue(i.ParameterName)),
+				"name":  name,
 				"value": aws.StringValue(i.ParameterValue),
 			})
 		}
@@ -433,3 +1290,191 @@ func expandElastiCacheParameter(param map[string]interface{}) *elasticache.Param
 		ParameterValue: aws.String(param["value"].(string)),
 	}
 }
+
+// FlattenParameterGroupAttributes returns the top-level attributes of a parameter group
+// that are present in the API response, and the names of those that are missing.
+func FlattenParameterGroupAttributes(parameterGroup *elasticache.CacheParameterGroup) (map[string]string, []string) {
+	attributes := make(map[string]string)
+	var missing []string
+
+	for _, v := range []struct {
+		key   string
+		value *string
+	}{
+		{"name", parameterGroup.CacheParameterGroupName},
+		{"family", parameterGroup.CacheParameterGroupFamily},
+		{"description", parameterGroup.Description},
+		{"arn", parameterGroup.ARN},
+	} {
+		if v.value == nil {
+			missing = append(missing, v.key)
+			continue
+		}
+
+		attributes[v.key] = aws.StringValue(v.value)
+	}
+
+	if name, ok := attributes["name"]; ok {
+		attributes["name_prefix"] = aws.StringValue(create.NamePrefixFromName(name))
+	}
+
+	return attributes, missing
+}
+
+// DisallowedParameters returns the sorted names of configured parameters that are not in the allowlist.
+func DisallowedParameters(configured, allowed *schema.Set) []string {
+	allowedNames := make(map[string]struct{}, allowed.Len())
+	for _, v := range allowed.List() {
+		allowedNames[strings.ToLower(v.(string))] = struct{}{}
+	}
+
+	var disallowed []string
+	for _, v := range configured.List() {
+		name := strings.ToLower(v.(map[string]interface{})["name"].(string))
+
+		// Names that are not yet known are checked on a later plan.
+		if name == "" {
+			continue
+		}
+
+		if _, ok := allowedNames[name]; !ok {
+			disallowed = append(disallowed, name)
+		}
+	}
+
+	sort.Strings(disallowed)
+
+	return disallowed
+}
+
+// InvalidParameters returns a sorted description of each configured parameter that does not exist in the
+// family's engine defaults or whose value is outside the parameter's AllowedValues.
+// Parameters skipped because the family predates them are not reported.
+func InvalidParameters(configured *schema.Set, family string, defaults []*elasticache.Parameter) []string {
+	byName := make(map[string]*elasticache.Parameter, len(defaults))
+	for _, v := range defaults {
+		byName[strings.ToLower(aws.StringValue(v.ParameterName))] = v
+	}
+
+	var invalid []string
+	for _, v := range configured.List() {
+		tfMap := v.(map[string]interface{})
+		name := strings.ToLower(tfMap["name"].(string))
+		value := tfMap["value"].(string)
+
+		// Names that are not yet known are checked on a later plan.
+		if name == "" || !ParameterSupportedByFamily(name, family) {
+			continue
+		}
+
+		parameter, ok := byName[name]
+
+		if !ok {
+			invalid = append(invalid, fmt.Sprintf("%s: unknown parameter", name))
+			continue
+		}
+
+		if value != "" && !ParameterValueAllowed(value, aws.StringValue(parameter.AllowedValues), aws.StringValue(parameter.DataType)) {
+			invalid = append(invalid, fmt.Sprintf("%s: value %q not in allowed values %q", name, value, aws.StringValue(parameter.AllowedValues)))
+		}
+	}
+
+	sort.Strings(invalid)
+
+	return invalid
+}
+
+// ParameterValueAllowed reports whether value satisfies the AllowedValues of an engine default parameter.
+// AllowedValues is either a comma separated list of values or, for integer parameters, a range such as
+// "0-100" or "1-". Allowed values in any other form are not checked.
+func ParameterValueAllowed(value, allowedValues, dataType string) bool {
+	if allowedValues == "" {
+		return true
+	}
+
+	if dataType == "integer" {
+		n, err := strconv.ParseInt(value, 10, 64)
+
+		if err != nil {
+			return false
+		}
+
+		for _, v := range strings.Split(allowedValues, ",") {
+			if min, max, ok := parseParameterValueRange(v); ok && n >= min && n <= max {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	values := strings.Split(allowedValues, ",")
+
+	if len(values) < 2 {
+		return true
+	}
+
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseParameterValueRange parses an integer AllowedValues element, e.g. "5", "0-100" or "1-".
+func parseParameterValueRange(v string) (int64, int64, bool) {
+	v = strings.TrimSpace(v)
+
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return n, n, true
+	}
+
+	if v == "" {
+		return 0, 0, false
+	}
+
+	// Skip the first character so that a negative minimum is not taken as the separator.
+	i := strings.Index(v[1:], "-") + 1
+
+	if i == 0 {
+		return 0, 0, false
+	}
+
+	min, err := strconv.ParseInt(v[:i], 10, 64)
+
+	if err != nil {
+		return 0, 0, false
+	}
+
+	if v[i+1:] == "" {
+		return min, math.MaxInt64, true
+	}
+
+	max, err := strconv.ParseInt(v[i+1:], 10, 64)
+
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return min, max, true
+}
+
+// ParametersJSON returns the flattened parameters as canonical JSON, sorted by name.
+func ParametersJSON(flattened []map[string]interface{}) (string, error) {
+	sorted := make([]map[string]interface{}, len(flattened))
+	copy(sorted, flattened)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i]["name"].(string) < sorted[j]["name"].(string)
+	})
+
+	b, err := json.Marshal(sorted)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
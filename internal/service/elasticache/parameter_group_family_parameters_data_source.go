@@ -0,0 +1,126 @@
+package elasticache
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceParameterGroupFamilyParameters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceParameterGroupFamilyParametersRead,
+
+		Schema: map[string]*schema.Schema{
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"change_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_modifiable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"minimum_engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(engineDefaultParameterSource_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceParameterGroupFamilyParametersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	family := d.Get("family").(string)
+
+	parameters, err := FindEngineDefaultParametersByFamily(conn, family)
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Parameter Group Family (%s) parameters: %w", family, err)
+	}
+
+	d.SetId(family)
+
+	if err := d.Set("parameters", flattenEngineDefaultParameters(parameters, d.Get("names").(*schema.Set), d.Get("source").(string))); err != nil {
+		return fmt.Errorf("error setting parameters: %w", err)
+	}
+
+	return nil
+}
+
+func flattenEngineDefaultParameters(parameters []*elasticache.Parameter, names *schema.Set, source string) []interface{} {
+	var tfList []interface{}
+
+	for _, parameter := range parameters {
+		if names.Len() > 0 && !names.Contains(aws.StringValue(parameter.ParameterName)) {
+			continue
+		}
+
+		if source != "" && aws.StringValue(parameter.Source) != source {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"allowed_values":         aws.StringValue(parameter.AllowedValues),
+			"change_type":            aws.StringValue(parameter.ChangeType),
+			"data_type":              aws.StringValue(parameter.DataType),
+			"description":            aws.StringValue(parameter.Description),
+			"is_modifiable":          aws.BoolValue(parameter.IsModifiable),
+			"minimum_engine_version": aws.StringValue(parameter.MinimumEngineVersion),
+			"name":                   aws.StringValue(parameter.ParameterName),
+			"source":                 aws.StringValue(parameter.Source),
+			"value":                  aws.StringValue(parameter.ParameterValue),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,71 @@
+package elasticache_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElastiCacheParameterGroupFamilyParametersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_elasticache_parameter_group_family_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupFamilyParametersDataSourceConfig("redis6.x"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "family", "redis6.x"),
+					resource.TestMatchResourceAttr(dataSourceName, "parameters.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroupFamilyParametersDataSource_names(t *testing.T) {
+	dataSourceName := "data.aws_elasticache_parameter_group_family_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupFamilyParametersDataSourceNamesConfig("redis6.x", "maxmemory-policy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.change_type", "immediate"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.data_type", "string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters.0.allowed_values"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.is_modifiable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.name", "maxmemory-policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.value", "volatile-lru"),
+				),
+			},
+		},
+	})
+}
+
+func testAccParameterGroupFamilyParametersDataSourceConfig(family string) string {
+	return fmt.Sprintf(`
+data "aws_elasticache_parameter_group_family_parameters" "test" {
+  family = %[1]q
+}
+`, family)
+}
+
+func testAccParameterGroupFamilyParametersDataSourceNamesConfig(family, name string) string {
+	return fmt.Sprintf(`
+data "aws_elasticache_parameter_group_family_parameters" "test" {
+  family = %[1]q
+  names  = [%[2]q]
+}
+`, family, name)
+}
@@ -3,18 +3,20 @@ package elasticache_test
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestAccElastiCacheParameterGroup_basic(t *testing.T) {
@@ -37,6 +39,7 @@ func TestAccElastiCacheParameterGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "family", "redis2.8"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "last_modified", ""),
 				),
 			},
 			{
@@ -44,6 +47,116 @@ func TestAccElastiCacheParameterGroup_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccParameterGroupImportStateARNFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_disappears(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfelasticache.ResourceParameterGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_generatedName(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupGeneratedNameConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					create.TestCheckResourceAttrNameGenerated(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "terraform-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_namePrefix(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupNamePrefixConfig("tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					create.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_allowedParameters(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterGroupAllowedParametersConfig(rName, "appendonly"),
+				ExpectError: regexp.MustCompile(`parameters not in allowed_parameters: appendonly`),
+			},
+			{
+				Config: testAccParameterGroupAllowedParametersConfig(rName, "activerehashing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+				),
+			},
 		},
 	})
 }
@@ -68,17 +181,25 @@ func TestAccElastiCacheParameterGroup_addParameter(t *testing.T) {
 						"name":  "appendonly",
 						"value": "yes",
 					}),
+					acctest.CheckResourceAttrRFC3339(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "parameters_json", `[{"name":"appendonly","value":"yes"}]`),
 				),
 			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"pending_changes",
+				},
 			},
 			{
 				Config: testAccParameterGroupParameter2Config(rName, "redis2.8", "appendonly", "yes", "appendfsync", "always"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.appendfsync", "modify"),
 					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
 						"name":  "appendonly",
@@ -94,6 +215,49 @@ func TestAccElastiCacheParameterGroup_addParameter(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheParameterGroup_importParameters(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupParameter3Config(rName, "redis2.8", "activerehashing", "no", "appendonly", "yes", "appendfsync", "always"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"pending_changes",
+				},
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(s))
+					}
+
+					if got := s[0].Attributes["parameter.#"]; got != "3" {
+						return fmt.Errorf("expected 3 imported parameters, got %s", got)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform-provider-aws/issues/116
 func TestAccElastiCacheParameterGroup_removeAllParameters(t *testing.T) {
 	var v elasticache.CacheParameterGroup
@@ -126,6 +290,10 @@ func TestAccElastiCacheParameterGroup_removeAllParameters(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckParameterGroupExists(resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.appendonly", "reset"),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.appendfsync", "reset"),
+					resource.TestCheckResourceAttr(resourceName, "parameters_json", `[]`),
 				),
 			},
 		},
@@ -167,6 +335,10 @@ func TestAccElastiCacheParameterGroup_RemoveReservedMemoryParameter_allParameter
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"pending_changes",
+				},
 			},
 		},
 	})
@@ -215,6 +387,10 @@ func TestAccElastiCacheParameterGroup_RemoveReservedMemoryParameter_remainingPar
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"pending_changes",
+				},
 			},
 		},
 	})
@@ -259,6 +435,48 @@ func TestAccElastiCacheParameterGroup_switchReservedMemoryParameter(t *testing.T
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"pending_changes",
+				},
+			},
+		},
+	})
+}
+
+// Parameters that the family does not support are skipped with a warning and kept in state as configured.
+func TestAccElastiCacheParameterGroup_unsupportedParameter(t *testing.T) {
+	var cacheParameterGroup1 elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupParameter2Config(rName, "redis2.8", "appendonly", "yes", "reserved-memory-percent", "25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "reserved-memory-percent",
+						"value": "25",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.appendonly", "modify"),
+					testAccCheckParameterGroupUserParameter(resourceName, "appendonly", "yes"),
+				),
+			},
+			{
+				Config: testAccParameterGroupParameter1Config(rName, "redis2.8", "appendonly", "yes"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_changes.%", "0"),
+				),
 			},
 		},
 	})
@@ -303,16 +521,19 @@ func TestAccElastiCacheParameterGroup_updateReservedMemoryParameter(t *testing.T
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"pending_changes",
+				},
 			},
 		},
 	})
 }
 
-func TestAccElastiCacheParameterGroup_uppercaseName(t *testing.T) {
+func TestAccElastiCacheParameterGroup_removeMemcachedParameter(t *testing.T) {
 	var v elasticache.CacheParameterGroup
 	resourceName := "aws_elasticache_parameter_group.test"
-	rInt := sdkacctest.RandInt()
-	rName := fmt.Sprintf("TF-ELASTIPG-%d", rInt)
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -321,22 +542,28 @@ func TestAccElastiCacheParameterGroup_uppercaseName(t *testing.T) {
 		CheckDestroy: testAccCheckParameterGroupDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccParameterGroupParameter1Config(rName, "redis2.8", "appendonly", "yes"),
+				Config: testAccParameterGroupParameter1Config(rName, "memcached1.6", "max_item_size", "2097152"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckParameterGroupExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf-elastipg-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "max_item_size",
+						"value": "2097152",
+					}),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccParameterGroupFamilyConfig(rName, "memcached1.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccElastiCacheParameterGroup_description(t *testing.T) {
+func TestAccElastiCacheParameterGroup_ignoreParameters(t *testing.T) {
 	var v elasticache.CacheParameterGroup
 	resourceName := "aws_elasticache_parameter_group.test"
 	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
@@ -348,23 +575,76 @@ func TestAccElastiCacheParameterGroup_description(t *testing.T) {
 		CheckDestroy: testAccCheckParameterGroupDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccParameterGroupDescriptionConfig(rName, "description1"),
+				Config: testAccParameterGroupParameter2Config(rName, "redis2.8", "appendonly", "yes", "appendfsync", "always"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckParameterGroupExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+				),
+			},
+			{
+				Config: testAccParameterGroupIgnoreParametersConfig(rName, "redis2.8", "appendonly", "yes", "appendfsync"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ignore_parameters.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ignore_parameters.*", "appendfsync"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "appendonly",
+						"value": "yes",
+					}),
+					testAccCheckParameterGroupUserParameter(resourceName, "appendfsync", "always"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_uppercaseName(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rInt := sdkacctest.RandInt()
+	rName := fmt.Sprintf("TF-ELASTIPG-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupParameter1Config(rName, "redis2.8", "appendonly", "yes"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf-elastipg-%d", rInt)),
 				),
 			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"pending_changes",
+				},
+			},
+			{
+				Config: testAccParameterGroupParameter1Config(rName, "redis2.8", "appendonly", "no"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("tf-elastipg-%d", rInt)),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "appendonly",
+						"value": "no",
+					}),
+					testAccCheckParameterGroupUserParameter(resourceName, "appendonly", "no"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccElastiCacheParameterGroup_tags(t *testing.T) {
-	var cacheParameterGroup1 elasticache.CacheParameterGroup
+func TestAccElastiCacheParameterGroup_description(t *testing.T) {
+	var v elasticache.CacheParameterGroup
 	resourceName := "aws_elasticache_parameter_group.test"
 	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
 
@@ -375,64 +655,250 @@ func TestAccElastiCacheParameterGroup_tags(t *testing.T) {
 		CheckDestroy: testAccCheckParameterGroupDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccParameterGroupTags1Config(rName, "redis2.8", "key1", "value1"),
+				Config: testAccParameterGroupConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
 				),
 			},
 			{
-				Config: testAccParameterGroupTags2Config(rName, "redis2.8", "key1", "updatedvalue1", "key2", "value2"),
+				Config: testAccParameterGroupDescriptionConfig(rName, "description1"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "updatedvalue1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccParameterGroupDescriptionConfig(rName, "description1"),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccParameterGroupConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:   testAccParameterGroupProviderDefaultDescriptionConfig(rName, "Managed by Platform Team"),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func testAccCheckParameterGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
-
+func TestAccElastiCacheParameterGroup_providerDefaultDescription(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupProviderDefaultDescriptionConfig(rName, "Managed by Platform Team"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Platform Team"),
+				),
+			},
+			{
+				Config:   testAccParameterGroupProviderDefaultDescriptionConfig(rName, "Managed by Another Team"),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccParameterGroupConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_includeSource(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupIncludeSourceConfig(rName, "engine-default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "include_source", "engine-default"),
+					resource.TestMatchResourceAttr(resourceName, "parameter.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+				// Engine default parameters are not configured.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_maxParametersPerRequest(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupMaxParametersPerRequestConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "max_parameters_per_request", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_modified",
+					"max_parameters_per_request",
+					"pending_changes",
+				},
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_sourceParameterGroupName(t *testing.T) {
+	var v elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupSourceParameterGroupNameConfig(rName, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "source_parameter_group_name", "aws_elasticache_parameter_group.source", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "appendonly",
+						"value": "no",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "appendfsync",
+						"value": "always",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "copied_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "copied_parameter.*", map[string]string{
+						"name":  "appendfsync",
+						"value": "always",
+					}),
+					testAccCheckParameterGroupUserParameter(resourceName, "appendfsync", "always"),
+					testAccCheckParameterGroupUserParameter(resourceName, "appendonly", "no"),
+				),
+			},
+			{
+				// Changing the source parameter group afterwards does not affect the copied parameters.
+				Config: testAccParameterGroupSourceParameterGroupNameConfig(rName, "everysec"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "appendfsync",
+						"value": "always",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "copied_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "copied_parameter.*", map[string]string{
+						"name":  "appendfsync",
+						"value": "always",
+					}),
+					testAccCheckParameterGroupUserParameter(resourceName, "appendfsync", "always"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheParameterGroup_tags(t *testing.T) {
+	var cacheParameterGroup1 elasticache.CacheParameterGroup
+	resourceName := "aws_elasticache_parameter_group.test"
+	rName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupTags1Config(rName, "redis2.8", "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccParameterGroupTags2Config(rName, "redis2.8", "key1", "updatedvalue1", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "updatedvalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &cacheParameterGroup1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckParameterGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_parameter_group" {
 			continue
 		}
 
-		resp, err := conn.DescribeCacheParameterGroups(
-			&elasticache.DescribeCacheParameterGroupsInput{
-				CacheParameterGroupName: aws.String(rs.Primary.ID),
-			})
+		_, err := tfelasticache.FindParameterGroupByName(conn, rs.Primary.ID)
 
-		if err == nil {
-			if len(resp.CacheParameterGroups) != 0 &&
-				*resp.CacheParameterGroups[0].CacheParameterGroupName == rs.Primary.ID {
-				return fmt.Errorf("Cache Parameter Group still exists")
-			}
+		if tfresource.NotFound(err) {
+			continue
 		}
 
-		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeCacheParameterGroupNotFoundFault) {
-			return nil
-		}
 		if err != nil {
 			return err
 		}
+
+		return fmt.Errorf("ElastiCache Parameter Group %s still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -466,24 +932,57 @@ func testAccCheckParameterGroupExists(n string, v *elasticache.CacheParameterGro
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
 
-		opts := elasticache.DescribeCacheParameterGroupsInput{
-			CacheParameterGroupName: aws.String(rs.Primary.ID),
+		output, err := tfelasticache.FindParameterGroupByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
 		}
 
-		resp, err := conn.DescribeCacheParameterGroups(&opts)
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckParameterGroupUserParameter(n, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+
+		parameters, err := tfelasticache.FindParameterGroupUserParametersByName(conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
-		if len(resp.CacheParameterGroups) != 1 ||
-			*resp.CacheParameterGroups[0].CacheParameterGroupName != rs.Primary.ID {
-			return fmt.Errorf("Cache Parameter Group not found")
+		for _, parameter := range parameters {
+			if aws.StringValue(parameter.ParameterName) != name {
+				continue
+			}
+
+			if got := aws.StringValue(parameter.ParameterValue); got != value {
+				return fmt.Errorf("Cache Parameter Group (%s) parameter %s: expected %q, got %q", rs.Primary.ID, name, value, got)
+			}
+
+			return nil
 		}
 
-		*v = *resp.CacheParameterGroups[0]
+		return fmt.Errorf("Cache Parameter Group (%s) parameter %s not found", rs.Primary.ID, name)
+	}
+}
 
-		return nil
+func testAccParameterGroupImportStateARNFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
 	}
 }
 
@@ -496,101 +995,575 @@ resource "aws_elasticache_parameter_group" "test" {
 `, rName)
 }
 
-func testAccParameterGroupDescriptionConfig(rName, description string) string {
-	return fmt.Sprintf(`
-resource "aws_elasticache_parameter_group" "test" {
-  description = %q
-  family      = "redis2.8"
-  name        = %q
-}
-`, description, rName)
-}
+func testAccParameterGroupProviderDefaultDescriptionConfig(rName, description string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  elasticache_parameter_group_default_description = %[2]q
+}
+
+resource "aws_elasticache_parameter_group" "test" {
+  family = "redis2.8"
+  name   = %[1]q
+}
+`, rName, description)
+}
+
+func testAccParameterGroupFamilyConfig(rName, family string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family = %q
+  name   = %q
+}
+`, family, rName)
+}
+
+func testAccParameterGroupGeneratedNameConfig() string {
+	return `
+resource "aws_elasticache_parameter_group" "test" {
+  family = "redis2.8"
+}
+`
+}
+
+func testAccParameterGroupNamePrefixConfig(namePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family      = "redis2.8"
+  name_prefix = %q
+}
+`, namePrefix)
+}
+
+func testAccParameterGroupAllowedParametersConfig(rName, parameterName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family             = "redis2.8"
+  name               = %[1]q
+  allowed_parameters = ["activerehashing"]
+
+  parameter {
+    name  = %[2]q
+    value = "yes"
+  }
+}
+`, rName, parameterName)
+}
+
+func testAccParameterGroupDescriptionConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  description = %q
+  family      = "redis2.8"
+  name        = %q
+}
+`, description, rName)
+}
+
+func testAccParameterGroupParameter1Config(rName, family, parameterName1, parameterValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family = %q
+  name   = %q
+
+  parameter {
+    name  = %q
+    value = %q
+  }
+}
+`, family, rName, parameterName1, parameterValue1)
+}
+
+func testAccParameterGroupParameter2Config(rName, family, parameterName1, parameterValue1, parameterName2, parameterValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family = %q
+  name   = %q
+
+  parameter {
+    name  = %q
+    value = %q
+  }
+
+  parameter {
+    name  = %q
+    value = %q
+  }
+}
+`, family, rName, parameterName1, parameterValue1, parameterName2, parameterValue2)
+}
+
+func testAccParameterGroupParameter3Config(rName, family, parameterName1, parameterValue1, parameterName2, parameterValue2, parameterName3, parameterValue3 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family = %q
+  name   = %q
+
+  parameter {
+    name  = %q
+    value = %q
+  }
+
+  parameter {
+    name  = %q
+    value = %q
+  }
+
+  parameter {
+    name  = %q
+    value = %q
+  }
+}
+`, family, rName, parameterName1, parameterValue1, parameterName2, parameterValue2, parameterName3, parameterValue3)
+}
+
+func testAccParameterGroupIncludeSourceConfig(rName, includeSource string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family         = "redis2.8"
+  name           = %[1]q
+  include_source = %[2]q
+}
+`, rName, includeSource)
+}
+
+func testAccParameterGroupMaxParametersPerRequestConfig(rName string, maxParametersPerRequest int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family                     = "redis2.8"
+  name                       = %[1]q
+  max_parameters_per_request = %[2]d
+
+  parameter {
+    name  = "appendonly"
+    value = "yes"
+  }
+
+  parameter {
+    name  = "appendfsync"
+    value = "always"
+  }
+}
+`, rName, maxParametersPerRequest)
+}
+
+func testAccParameterGroupSourceParameterGroupNameConfig(rName, appendfsync string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "source" {
+  family = "redis2.8"
+  name   = "%[1]s-source"
+
+  parameter {
+    name  = "appendonly"
+    value = "yes"
+  }
+
+  parameter {
+    name  = "appendfsync"
+    value = %[2]q
+  }
+}
+
+resource "aws_elasticache_parameter_group" "test" {
+  family                      = "redis2.8"
+  name                        = %[1]q
+  source_parameter_group_name = aws_elasticache_parameter_group.source.name
+
+  parameter {
+    name  = "appendonly"
+    value = "no"
+  }
+}
+`, rName, appendfsync)
+}
+
+func testAccParameterGroupIgnoreParametersConfig(rName, family, parameterName1, parameterValue1, ignoreParameterName1 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family            = %[1]q
+  name              = %[2]q
+  ignore_parameters = [%[5]q]
+
+  parameter {
+    name  = %[3]q
+    value = %[4]q
+  }
+}
+`, family, rName, parameterName1, parameterValue1, ignoreParameterName1)
+}
+
+func testAccParameterGroupTags1Config(rName, family, tagName1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family = %[1]q
+  name   = %[2]q
+
+  tags = {
+    %[3]s = %[4]q
+  }
+}
+`, family, rName, tagName1, tagValue1)
+}
+
+func testAccParameterGroupTags2Config(rName, family, tagName1, tagValue1, tagName2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_parameter_group" "test" {
+  family = %[1]q
+  name   = %[2]q
+
+  tags = {
+    %[3]s = %[4]q
+    %[5]s = %[6]q
+  }
+}
+`, family, rName, tagName1, tagValue1, tagName2, tagValue2)
+}
+
+func TestFlattenElasticacheParameters(t *testing.T) {
+	cases := []struct {
+		Input  []*elasticache.Parameter
+		Ignore *schema.Set
+		Output []map[string]interface{}
+	}{
+		{
+			Input: []*elasticache.Parameter{
+				{
+					ParameterName:  aws.String("activerehashing"),
+					ParameterValue: aws.String("yes"),
+				},
+			},
+			Output: []map[string]interface{}{
+				{
+					"name":  "activerehashing",
+					"value": "yes",
+				},
+			},
+		},
+		{
+			Input: []*elasticache.Parameter{
+				{
+					ParameterName:  aws.String("activerehashing"),
+					ParameterValue: aws.String("yes"),
+				},
+				{
+					ParameterName:  aws.String("maxmemory"),
+					ParameterValue: aws.String("1073741824"),
+				},
+			},
+			Ignore: schema.NewSet(schema.HashString, []interface{}{"maxmemory"}),
+			Output: []map[string]interface{}{
+				{
+					"name":  "activerehashing",
+					"value": "yes",
+				},
+			},
+		},
+		{
+			Input: []*elasticache.Parameter{
+				{
+					ParameterName:  aws.String("activerehashing"),
+					ParameterValue: aws.String("yes"),
+				},
+				{
+					ParameterName:  aws.String("maxmemory"),
+					ParameterValue: aws.String("1073741824"),
+				},
+			},
+			Ignore: schema.NewSet(schema.HashString, []interface{}{"MaxMemory"}),
+			Output: []map[string]interface{}{
+				{
+					"name":  "activerehashing",
+					"value": "yes",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := tfelasticache.FlattenParameters(tc.Input, tc.Ignore)
+		if !reflect.DeepEqual(output, tc.Output) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Output)
+		}
+	}
+}
+
+func TestExcludeIgnoredParameters(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []*elasticache.ParameterNameValue
+		Ignore   *schema.Set
+		Expected []string
+	}{
+		{
+			Name: "no ignore",
+			Input: []*elasticache.ParameterNameValue{
+				{ParameterName: aws.String("appendonly"), ParameterValue: aws.String("yes")},
+				{ParameterName: aws.String("appendfsync"), ParameterValue: aws.String("always")},
+			},
+			Expected: []string{"appendonly", "appendfsync"},
+		},
+		{
+			Name: "ignored",
+			Input: []*elasticache.ParameterNameValue{
+				{ParameterName: aws.String("appendonly"), ParameterValue: aws.String("yes")},
+				{ParameterName: aws.String("appendfsync"), ParameterValue: aws.String("always")},
+			},
+			Ignore:   schema.NewSet(schema.HashString, []interface{}{"appendfsync"}),
+			Expected: []string{"appendonly"},
+		},
+		{
+			Name: "mixed case",
+			Input: []*elasticache.ParameterNameValue{
+				{ParameterName: aws.String("appendonly"), ParameterValue: aws.String("yes")},
+				{ParameterName: aws.String("AppendFsync"), ParameterValue: aws.String("always")},
+			},
+			Ignore:   schema.NewSet(schema.HashString, []interface{}{"appendFSYNC"}),
+			Expected: []string{"appendonly"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var names []string
+			for _, p := range tfelasticache.ExcludeIgnoredParameters(tc.Input, tc.Ignore) {
+				names = append(names, aws.StringValue(p.ParameterName))
+			}
+
+			if !reflect.DeepEqual(names, tc.Expected) {
+				t.Errorf("got %v, expected %v", names, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenParameterGroupAttributes(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Input      *elasticache.CacheParameterGroup
+		Attributes map[string]string
+		Missing    []string
+	}{
+		{
+			Name: "complete",
+			Input: &elasticache.CacheParameterGroup{
+				ARN:                       aws.String("arn:aws:elasticache:us-west-2:123456789012:parametergroup:test"),
+				CacheParameterGroupFamily: aws.String("redis6.x"),
+				CacheParameterGroupName:   aws.String("test"),
+				Description:               aws.String("Managed by Terraform"),
+			},
+			Attributes: map[string]string{
+				"arn":         "arn:aws:elasticache:us-west-2:123456789012:parametergroup:test",
+				"description": "Managed by Terraform",
+				"family":      "redis6.x",
+				"name":        "test",
+				"name_prefix": "",
+			},
+		},
+		{
+			Name: "partial",
+			Input: &elasticache.CacheParameterGroup{
+				CacheParameterGroupName: aws.String("terraform-20220101000000000000000001"),
+			},
+			Attributes: map[string]string{
+				"name":        "terraform-20220101000000000000000001",
+				"name_prefix": "terraform-",
+			},
+			Missing: []string{"family", "description", "arn"},
+		},
+		{
+			Name:       "empty",
+			Input:      &elasticache.CacheParameterGroup{},
+			Attributes: map[string]string{},
+			Missing:    []string{"name", "family", "description", "arn"},
+		},
+	}
+
+	for _, tc := range cases {
+		attributes, missing := tfelasticache.FlattenParameterGroupAttributes(tc.Input)
 
-func testAccParameterGroupParameter1Config(rName, family, parameterName1, parameterValue1 string) string {
-	return fmt.Sprintf(`
-resource "aws_elasticache_parameter_group" "test" {
-  family = %q
-  name   = %q
+		if !reflect.DeepEqual(attributes, tc.Attributes) {
+			t.Errorf("%s: got attributes %#v, expected %#v", tc.Name, attributes, tc.Attributes)
+		}
 
-  parameter {
-    name  = %q
-    value = %q
-  }
-}
-`, family, rName, parameterName1, parameterValue1)
+		if !reflect.DeepEqual(missing, tc.Missing) {
+			t.Errorf("%s: got missing %#v, expected %#v", tc.Name, missing, tc.Missing)
+		}
+	}
 }
 
-func testAccParameterGroupParameter2Config(rName, family, parameterName1, parameterValue1, parameterName2, parameterValue2 string) string {
-	return fmt.Sprintf(`
-resource "aws_elasticache_parameter_group" "test" {
-  family = %q
-  name   = %q
+func TestDisallowedParameters(t *testing.T) {
+	parameterSet := func(names ...string) *schema.Set {
+		s := schema.NewSet(tfelasticache.ParameterHash, nil)
+		for _, name := range names {
+			s.Add(map[string]interface{}{"name": name, "value": "yes"})
+		}
+		return s
+	}
 
-  parameter {
-    name  = %q
-    value = %q
-  }
+	cases := []struct {
+		Configured *schema.Set
+		Allowed    *schema.Set
+		Output     []string
+	}{
+		{
+			Configured: parameterSet("activerehashing"),
+			Allowed:    schema.NewSet(schema.HashString, []interface{}{"activerehashing", "appendonly"}),
+			Output:     nil,
+		},
+		{
+			Configured: parameterSet("ActiveRehashing"),
+			Allowed:    schema.NewSet(schema.HashString, []interface{}{"activerehashing"}),
+			Output:     nil,
+		},
+		{
+			Configured: parameterSet("appendonly", "activerehashing", "notify-keyspace-events"),
+			Allowed:    schema.NewSet(schema.HashString, []interface{}{"activerehashing"}),
+			Output:     []string{"appendonly", "notify-keyspace-events"},
+		},
+	}
 
-  parameter {
-    name  = %q
-    value = %q
-  }
-}
-`, family, rName, parameterName1, parameterValue1, parameterName2, parameterValue2)
+	for _, tc := range cases {
+		output := tfelasticache.DisallowedParameters(tc.Configured, tc.Allowed)
+		if !reflect.DeepEqual(output, tc.Output) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Output)
+		}
+	}
 }
 
-func testAccParameterGroupTags1Config(rName, family, tagName1, tagValue1 string) string {
-	return fmt.Sprintf(`
-resource "aws_elasticache_parameter_group" "test" {
-  family = %[1]q
-  name   = %[2]q
+func TestInvalidParameters(t *testing.T) {
+	defaults := []*elasticache.Parameter{
+		{
+			AllowedValues: aws.String("yes,no"),
+			DataType:      aws.String("string"),
+			ParameterName: aws.String("activerehashing"),
+		},
+		{
+			AllowedValues: aws.String("0-"),
+			DataType:      aws.String("integer"),
+			ParameterName: aws.String("databases"),
+		},
+	}
 
-  tags = {
-    %[3]s = %[4]q
-  }
-}
-`, family, rName, tagName1, tagValue1)
-}
+	cases := []struct {
+		Name       string
+		Configured []map[string]interface{}
+		Family     string
+		Output     []string
+	}{
+		{
+			Name: "valid",
+			Configured: []map[string]interface{}{
+				{"name": "activerehashing", "value": "no"},
+				{"name": "databases", "value": "32"},
+			},
+			Family: "redis6.x",
+			Output: nil,
+		},
+		{
+			Name: "unknown name",
+			Configured: []map[string]interface{}{
+				{"name": "activerehash", "value": "yes"},
+			},
+			Family: "redis6.x",
+			Output: []string{"activerehash: unknown parameter"},
+		},
+		{
+			Name: "value not allowed",
+			Configured: []map[string]interface{}{
+				{"name": "activerehashing", "value": "maybe"},
+				{"name": "databases", "value": "-1"},
+			},
+			Family: "redis6.x",
+			Output: []string{
+				`activerehashing: value "maybe" not in allowed values "yes,no"`,
+				`databases: value "-1" not in allowed values "0-"`,
+			},
+		},
+		{
+			Name: "unsupported by family",
+			Configured: []map[string]interface{}{
+				{"name": "reserved-memory-percent", "value": "25"},
+			},
+			Family: "redis2.8",
+			Output: nil,
+		},
+	}
 
-func testAccParameterGroupTags2Config(rName, family, tagName1, tagValue1, tagName2, tagValue2 string) string {
-	return fmt.Sprintf(`
-resource "aws_elasticache_parameter_group" "test" {
-  family = %[1]q
-  name   = %[2]q
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			configured := schema.NewSet(tfelasticache.ParameterHash, nil)
+			for _, v := range tc.Configured {
+				configured.Add(v)
+			}
 
-  tags = {
-    %[3]s = %[4]q
-    %[5]s = %[6]q
-  }
+			output := tfelasticache.InvalidParameters(configured, tc.Family, defaults)
+			if !reflect.DeepEqual(output, tc.Output) {
+				t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Output)
+			}
+		})
+	}
 }
-`, family, rName, tagName1, tagValue1, tagName2, tagValue2)
+
+func TestParameterValueAllowed(t *testing.T) {
+	cases := []struct {
+		Value         string
+		AllowedValues string
+		DataType      string
+		Expected      bool
+	}{
+		{Value: "anything", AllowedValues: "", DataType: "string", Expected: true},
+		{Value: "yes", AllowedValues: "yes,no", DataType: "string", Expected: true},
+		{Value: "YES", AllowedValues: "yes,no", DataType: "string", Expected: true},
+		{Value: "true", AllowedValues: "yes,no", DataType: "string", Expected: false},
+		{Value: "Ex", AllowedValues: "free-form", DataType: "string", Expected: true},
+		{Value: "5", AllowedValues: "0-10", DataType: "integer", Expected: true},
+		{Value: "11", AllowedValues: "0-10", DataType: "integer", Expected: false},
+		{Value: "1000000", AllowedValues: "1-", DataType: "integer", Expected: true},
+		{Value: "0", AllowedValues: "1-", DataType: "integer", Expected: false},
+		{Value: "-1", AllowedValues: "-1-100", DataType: "integer", Expected: true},
+		{Value: "3", AllowedValues: "1,3,5", DataType: "integer", Expected: true},
+		{Value: "4", AllowedValues: "1,3,5", DataType: "integer", Expected: false},
+		{Value: "ten", AllowedValues: "0-10", DataType: "integer", Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := tfelasticache.ParameterValueAllowed(tc.Value, tc.AllowedValues, tc.DataType); got != tc.Expected {
+			t.Errorf("ParameterValueAllowed(%q, %q, %q) = %t, expected %t", tc.Value, tc.AllowedValues, tc.DataType, got, tc.Expected)
+		}
+	}
 }
 
-func TestFlattenElasticacheParameters(t *testing.T) {
+func TestParametersJSON(t *testing.T) {
 	cases := []struct {
-		Input  []*elasticache.Parameter
-		Output []map[string]interface{}
+		Input  []map[string]interface{}
+		Output string
 	}{
 		{
-			Input: []*elasticache.Parameter{
+			Input:  []map[string]interface{}{},
+			Output: `[]`,
+		},
+		{
+			Input: []map[string]interface{}{
 				{
-					ParameterName:  aws.String("activerehashing"),
-					ParameterValue: aws.String("yes"),
+					"name":  "maxmemory-policy",
+					"value": "allkeys-lru",
 				},
-			},
-			Output: []map[string]interface{}{
 				{
 					"name":  "activerehashing",
 					"value": "yes",
 				},
 			},
+			Output: `[{"name":"activerehashing","value":"yes"},{"name":"maxmemory-policy","value":"allkeys-lru"}]`,
 		},
 	}
 
 	for _, tc := range cases {
-		output := tfelasticache.FlattenParameters(tc.Input)
-		if !reflect.DeepEqual(output, tc.Output) {
-			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Output)
+		output, err := tfelasticache.ParametersJSON(tc.Input)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if output != tc.Output {
+			t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s", output, tc.Output)
 		}
 	}
 }
@@ -731,3 +1704,260 @@ func TestElastiCacheParameterChanges(t *testing.T) {
 		}
 	}
 }
+
+func TestElastiCacheSourceParametersToCopy(t *testing.T) {
+	sourceParameters := []*elasticache.Parameter{
+		{
+			ParameterName:  aws.String("appendonly"),
+			ParameterValue: aws.String("yes"),
+		},
+		{
+			ParameterName:  aws.String("appendfsync"),
+			ParameterValue: aws.String("always"),
+		},
+		{
+			ParameterName: aws.String("activerehashing"),
+		},
+	}
+	configured := schema.NewSet(tfelasticache.ParameterHash, []interface{}{
+		map[string]interface{}{
+			"name":  "appendonly",
+			"value": "no",
+		},
+	})
+
+	expected := []*elasticache.ParameterNameValue{
+		{
+			ParameterName:  aws.String("appendfsync"),
+			ParameterValue: aws.String("always"),
+		},
+	}
+
+	if got := tfelasticache.SourceParametersToCopy(sourceParameters, configured); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", got, expected)
+	}
+}
+
+func TestElastiCacheMergeCopiedParameters(t *testing.T) {
+	configured := schema.NewSet(tfelasticache.ParameterHash, []interface{}{
+		map[string]interface{}{
+			"name":  "appendonly",
+			"value": "no",
+		},
+		map[string]interface{}{
+			"name":  "maxmemory-policy",
+			"value": "allkeys-lru",
+		},
+	})
+	copied := schema.NewSet(tfelasticache.ParameterHash, []interface{}{
+		map[string]interface{}{
+			"name":  "AppendOnly",
+			"value": "yes",
+		},
+		map[string]interface{}{
+			"name":  "appendfsync",
+			"value": "always",
+		},
+		map[string]interface{}{
+			"name":  "tcp-keepalive",
+			"value": "360",
+		},
+	})
+	ignore := schema.NewSet(schema.HashString, []interface{}{"TCP-keepalive"})
+
+	expected := schema.NewSet(tfelasticache.ParameterHash, []interface{}{
+		map[string]interface{}{
+			"name":  "appendonly",
+			"value": "no",
+		},
+		map[string]interface{}{
+			"name":  "maxmemory-policy",
+			"value": "allkeys-lru",
+		},
+		map[string]interface{}{
+			"name":  "appendfsync",
+			"value": "always",
+		},
+	})
+
+	got := schema.NewSet(tfelasticache.ParameterHash, tfelasticache.MergeCopiedParameters(configured, copied, ignore))
+
+	if !got.Equal(expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", got.List(), expected.List())
+	}
+}
+
+func TestParameterGroupNameFromImportID(t *testing.T) {
+	cases := []struct {
+		Name          string
+		ID            string
+		ExpectedName  string
+		ExpectedError bool
+	}{
+		{
+			Name:         "name",
+			ID:           "redis-params",
+			ExpectedName: "redis-params",
+		},
+		{
+			Name:         "ARN",
+			ID:           "arn:aws:elasticache:us-west-2:123456789012:parametergroup:redis-params",
+			ExpectedName: "redis-params",
+		},
+		{
+			Name:         "ARN with slash",
+			ID:           "arn:aws:elasticache:us-west-2:123456789012:parametergroup/redis-params",
+			ExpectedName: "redis-params",
+		},
+		{
+			Name:          "ARN of another resource type",
+			ID:            "arn:aws:elasticache:us-west-2:123456789012:subnetgroup:redis-subnets",
+			ExpectedError: true,
+		},
+		{
+			Name:          "ARN of another service",
+			ID:            "arn:aws:rds:us-west-2:123456789012:pg:redis-params",
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := tfelasticache.ParameterGroupNameFromImportID(tc.ID)
+
+		if tc.ExpectedError {
+			if err == nil {
+				t.Errorf("Case %q: expected error, got none", tc.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Case %q: unexpected error: %s", tc.Name, err)
+			continue
+		}
+
+		if got != tc.ExpectedName {
+			t.Errorf("Case %q: expected %q, got %q", tc.Name, tc.ExpectedName, got)
+		}
+	}
+}
+
+func TestReservedMemoryWorkaroundApplies(t *testing.T) {
+	cases := []struct {
+		Family   string
+		Expected bool
+	}{
+		{
+			Family:   "redis2.8",
+			Expected: true,
+		},
+		{
+			Family:   "redis6.x",
+			Expected: true,
+		},
+		{
+			Family:   "memcached1.4",
+			Expected: false,
+		},
+		{
+			Family:   "memcached1.6",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := tfelasticache.ReservedMemoryWorkaroundApplies(tc.Family); got != tc.Expected {
+			t.Errorf("Family %q: expected %t, got %t", tc.Family, tc.Expected, got)
+		}
+	}
+}
+
+func TestParameterSupportedByFamily(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Family   string
+		Expected bool
+	}{
+		{
+			Name:     "reserved-memory-percent",
+			Family:   "redis2.6",
+			Expected: false,
+		},
+		{
+			Name:     "reserved-memory-percent",
+			Family:   "redis2.8",
+			Expected: false,
+		},
+		{
+			Name:     "reserved-memory-percent",
+			Family:   "redis3.2",
+			Expected: true,
+		},
+		{
+			Name:     "reserved-memory-percent",
+			Family:   "redis6.x",
+			Expected: true,
+		},
+		{
+			Name:     "reserved-memory-percent",
+			Family:   "memcached1.6",
+			Expected: false,
+		},
+		{
+			Name:     "activerehashing",
+			Family:   "redis2.6",
+			Expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := tfelasticache.ParameterSupportedByFamily(tc.Name, tc.Family); got != tc.Expected {
+			t.Errorf("Parameter %q, family %q: expected %t, got %t", tc.Name, tc.Family, tc.Expected, got)
+		}
+	}
+}
+
+func TestParametersRequireReboot(t *testing.T) {
+	parameters := []*elasticache.Parameter{
+		{
+			ParameterName: aws.String("appendonly"),
+			ChangeType:    aws.String(elasticache.ChangeTypeImmediate),
+		},
+		{
+			ParameterName: aws.String("databases"),
+			ChangeType:    aws.String(elasticache.ChangeTypeRequiresReboot),
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Names    []string
+		Expected bool
+	}{
+		{
+			Name:     "none",
+			Expected: false,
+		},
+		{
+			Name:     "immediate",
+			Names:    []string{"appendonly"},
+			Expected: false,
+		},
+		{
+			Name:     "requires reboot",
+			Names:    []string{"appendonly", "databases"},
+			Expected: true,
+		},
+		{
+			Name:     "unknown",
+			Names:    []string{"tf-acc-test"},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := tfelasticache.ParametersRequireReboot(parameters, tc.Names); got != tc.Expected {
+			t.Errorf("Case %q: expected %t, got %t", tc.Name, tc.Expected, got)
+		}
+	}
+}
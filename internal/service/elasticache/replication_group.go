@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -32,7 +34,7 @@ func ResourceReplicationGroup() *schema.Resource {
 		Update: resourceReplicationGroupUpdate,
 		Delete: resourceReplicationGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceReplicationGroupImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,11 +59,71 @@ func ResourceReplicationGroup() *schema.Resource {
 				Sensitive:    true,
 				ValidateFunc: validReplicationGroupAuthToken,
 			},
+			"auth_token_update_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(elasticache.AuthTokenUpdateStrategyType_Values(), false),
+			},
 			"auto_minor_version_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
+			"auto_scaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"scalable_dimension": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(replicationGroupScalableDimension_Values(), false),
+						},
+						"target_tracking": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disable_scale_in": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"predefined_metric_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(replicationGroupPredefinedMetricType_Values(), false),
+									},
+									"scale_in_cooldown": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"scale_out_cooldown": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"target_value": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"automatic_failover_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -153,6 +215,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				},
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"log_delivery_configuration": logDeliveryConfigurationSchema(),
 			"member_clusters": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -277,9 +340,14 @@ func ResourceReplicationGroup() *schema.Resource {
 			"transit_encryption_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
+			"transit_encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(elasticache.TransitEncryptionMode_Values(), false),
+			},
 			"kms_key_id": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -308,6 +376,8 @@ func ResourceReplicationGroup() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			CustomizeDiffValidateReplicationGroupAutomaticFailover,
 			CustomizeDiffElastiCacheEngineVersion,
+			CustomizeDiffReplicationGroupTransitEncryption,
+			CustomizeDiffValidateReplicationGroupAuthTokenUpdateStrategy,
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("number_cache_clusters") ||
 					diff.HasChange("cluster_mode.0.num_node_groups") ||
@@ -391,6 +461,10 @@ func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) er
 		params.NotificationTopicArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("log_delivery_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		params.LogDeliveryConfigurations = expandLogDeliveryConfigurationRequests(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("kms_key_id"); ok {
 		params.KmsKeyId = aws.String(v.(string))
 	}
@@ -411,6 +485,10 @@ func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) er
 		params.TransitEncryptionEnabled = aws.Bool(d.Get("transit_encryption_enabled").(bool))
 	}
 
+	if v, ok := d.GetOk("transit_encryption_mode"); ok {
+		params.TransitEncryptionMode = aws.String(v.(string))
+	}
+
 	if _, ok := d.GetOk("at_rest_encryption_enabled"); ok {
 		params.AtRestEncryptionEnabled = aws.Bool(d.Get("at_rest_encryption_enabled").(bool))
 	}
@@ -457,6 +535,12 @@ func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if v, ok := d.GetOk("auto_scaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := putReplicationGroupAutoScaling(meta.(*conns.AWSClient).AppAutoScalingConn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return fmt.Errorf("error configuring ElastiCache Replication Group (%s) auto scaling: %w", d.Id(), err)
+		}
+	}
+
 	return resourceReplicationGroupRead(d, meta)
 }
 
@@ -520,6 +604,23 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("replication_group_id", rgp.ReplicationGroupId)
 	d.Set("arn", rgp.ARN)
 	d.Set("data_tiering_enabled", aws.StringValue(rgp.DataTiering) == elasticache.DataTieringStatusEnabled)
+	d.Set("transit_encryption_mode", rgp.TransitEncryptionMode)
+
+	if err := d.Set("log_delivery_configuration", flattenLogDeliveryConfigurations(rgp.LogDeliveryConfigurations)); err != nil {
+		return fmt.Errorf("error setting log_delivery_configuration: %w", err)
+	}
+
+	// Only read auto scaling managed by this resource, leaving scalable targets
+	// managed with aws_appautoscaling_target untouched.
+	if dimension := replicationGroupAutoScalingDimension(d.Get("auto_scaling").([]interface{})); dimension != "" {
+		autoScaling, err := findReplicationGroupAutoScaling(meta.(*conns.AWSClient).AppAutoScalingConn, d.Id(), dimension)
+		if err != nil {
+			return fmt.Errorf("error reading ElastiCache Replication Group (%s) auto scaling: %w", d.Id(), err)
+		}
+		if err := d.Set("auto_scaling", autoScaling); err != nil {
+			return fmt.Errorf("error setting auto_scaling: %w", err)
+		}
+	}
 
 	// Tags cannot be read when the replication group is not Available
 	_, err = WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
@@ -657,6 +758,12 @@ func resourceReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		requestUpdate = true
 	}
 
+	if d.HasChange("log_delivery_configuration") {
+		o, n := d.GetChange("log_delivery_configuration")
+		params.LogDeliveryConfigurations = expandLogDeliveryConfigurationChanges(o.(*schema.Set), n.(*schema.Set))
+		requestUpdate = true
+	}
+
 	if d.HasChange("parameter_group_name") {
 		params.CacheParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		requestUpdate = true
@@ -688,6 +795,18 @@ func resourceReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		requestUpdate = true
 	}
 
+	if d.HasChanges("transit_encryption_enabled", "transit_encryption_mode") {
+		// Enabling in-transit encryption on an existing replication group requires the "preferred"
+		// mode first; "required" can only be set once all clients have moved to TLS.
+		params.TransitEncryptionEnabled = aws.Bool(d.Get("transit_encryption_enabled").(bool))
+		if v, ok := d.GetOk("transit_encryption_mode"); ok {
+			params.TransitEncryptionMode = aws.String(v.(string))
+		} else if d.Get("transit_encryption_enabled").(bool) {
+			params.TransitEncryptionMode = aws.String(elasticache.TransitEncryptionModePreferred)
+		}
+		requestUpdate = true
+	}
+
 	if requestUpdate {
 		_, err := conn.ModifyReplicationGroup(params)
 		if err != nil {
@@ -696,11 +815,19 @@ func resourceReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("auth_token") {
+		authTokenUpdateStrategy := elasticache.AuthTokenUpdateStrategyTypeRotate
+		if v, ok := d.GetOk("auth_token_update_strategy"); ok {
+			authTokenUpdateStrategy = v.(string)
+		}
+
 		params := &elasticache.ModifyReplicationGroupInput{
 			ApplyImmediately:        aws.Bool(true),
 			ReplicationGroupId:      aws.String(d.Id()),
-			AuthTokenUpdateStrategy: aws.String("ROTATE"),
-			AuthToken:               aws.String(d.Get("auth_token").(string)),
+			AuthTokenUpdateStrategy: aws.String(authTokenUpdateStrategy),
+		}
+
+		if v, ok := d.GetOk("auth_token"); ok {
+			params.AuthToken = aws.String(v.(string))
 		}
 
 		_, err := conn.ModifyReplicationGroup(params)
@@ -721,6 +848,25 @@ func resourceReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error waiting for modification: %w", err)
 	}
 
+	if d.HasChange("auto_scaling") {
+		autoScalingConn := meta.(*conns.AWSClient).AppAutoScalingConn
+		o, n := d.GetChange("auto_scaling")
+		oldDimension := replicationGroupAutoScalingDimension(o.([]interface{}))
+		newDimension := replicationGroupAutoScalingDimension(n.([]interface{}))
+
+		if oldDimension != "" && oldDimension != newDimension {
+			if err := deleteReplicationGroupAutoScaling(autoScalingConn, d.Id(), oldDimension); err != nil {
+				return fmt.Errorf("error removing ElastiCache Replication Group (%s) auto scaling: %w", d.Id(), err)
+			}
+		}
+
+		if newDimension != "" {
+			if err := putReplicationGroupAutoScaling(autoScalingConn, d.Id(), n.([]interface{})[0].(map[string]interface{})); err != nil {
+				return fmt.Errorf("error configuring ElastiCache Replication Group (%s) auto scaling: %w", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceReplicationGroupRead(d, meta)
 }
 
@@ -734,6 +880,12 @@ func resourceReplicationGroupDelete(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if dimension := replicationGroupAutoScalingDimension(d.Get("auto_scaling").([]interface{})); dimension != "" {
+		if err := deleteReplicationGroupAutoScaling(meta.(*conns.AWSClient).AppAutoScalingConn, d.Id(), dimension); err != nil {
+			return fmt.Errorf("error removing ElastiCache Replication Group (%s) auto scaling: %w", d.Id(), err)
+		}
+	}
+
 	var finalSnapshotID = d.Get("final_snapshot_identifier").(string)
 	err := deleteElasticacheReplicationGroup(d.Id(), conn, finalSnapshotID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -743,6 +895,43 @@ func resourceReplicationGroupDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceReplicationGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, dimension, err := ReplicationGroupParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+
+	if dimension != "" {
+		d.Set("auto_scaling", []interface{}{map[string]interface{}{"scalable_dimension": dimension}})
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// ReplicationGroupParseImportID returns the replication group ID and, optionally, the
+// scalable dimension of the auto scaling managed by the resource from an import ID
+// in the format REPLICATION-GROUP-ID or REPLICATION-GROUP-ID/SCALABLE-DIMENSION.
+func ReplicationGroupParseImportID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" {
+		for _, dimension := range replicationGroupScalableDimension_Values() {
+			if parts[1] == dimension {
+				return parts[0], parts[1], nil
+			}
+		}
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%s), expected REPLICATION-GROUP-ID or REPLICATION-GROUP-ID/SCALABLE-DIMENSION", id)
+}
+
 func DisassociateReplicationGroup(conn *elasticache.ElastiCache, globalReplicationGroupID, id, region string, readyTimeout time.Duration) error {
 	input := &elasticache.DisassociateGlobalReplicationGroupInput{
 		GlobalReplicationGroupId: aws.String(globalReplicationGroupID),
@@ -829,6 +1018,145 @@ func deleteElasticacheReplicationGroup(replicationGroupID string, conn *elastica
 	return nil
 }
 
+// putReplicationGroupAutoScaling registers the replication group as an Application Auto Scaling
+// scalable target and attaches the configured target tracking policy. Both calls are idempotent.
+func putReplicationGroupAutoScaling(conn *applicationautoscaling.ApplicationAutoScaling, id string, tfMap map[string]interface{}) error {
+	resourceID := replicationGroupAutoScalingResourceID(id)
+	dimension := tfMap["scalable_dimension"].(string)
+
+	targetInput := &applicationautoscaling.RegisterScalableTargetInput{
+		MaxCapacity:       aws.Int64(int64(tfMap["max_capacity"].(int))),
+		MinCapacity:       aws.Int64(int64(tfMap["min_capacity"].(int))),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceElasticache),
+	}
+
+	log.Printf("[DEBUG] Registering ElastiCache Replication Group (%s) scalable target: %s", id, targetInput)
+	_, err := tfresource.RetryWhen(tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.RegisterScalableTarget(targetInput)
+		},
+		func(err error) (bool, error) {
+			// The Application Auto Scaling service-linked role is created on first use.
+			if tfawserr.ErrMessageContains(err, applicationautoscaling.ErrCodeValidationException, "Unable to assume IAM role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("registering scalable target: %w", err)
+	}
+
+	policyInput := &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:        aws.String(replicationGroupAutoScalingPolicyName(id, dimension)),
+		PolicyType:        aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceElasticache),
+	}
+
+	if v, ok := tfMap["target_tracking"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		policyInput.TargetTrackingScalingPolicyConfiguration = expandReplicationGroupTargetTrackingScalingPolicyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Putting ElastiCache Replication Group (%s) scaling policy: %s", id, policyInput)
+	if _, err := conn.PutScalingPolicy(policyInput); err != nil {
+		return fmt.Errorf("putting scaling policy: %w", err)
+	}
+
+	return nil
+}
+
+// deleteReplicationGroupAutoScaling deregisters the replication group's scalable target.
+// Application Auto Scaling deletes the scaling policies associated with the target.
+func deleteReplicationGroupAutoScaling(conn *applicationautoscaling.ApplicationAutoScaling, id, dimension string) error {
+	input := &applicationautoscaling.DeregisterScalableTargetInput{
+		ResourceId:        aws.String(replicationGroupAutoScalingResourceID(id)),
+		ScalableDimension: aws.String(dimension),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceElasticache),
+	}
+
+	log.Printf("[DEBUG] Deregistering ElastiCache Replication Group (%s) scalable target: %s", id, input)
+	_, err := conn.DeregisterScalableTarget(input)
+
+	if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deregistering scalable target: %w", err)
+	}
+
+	return nil
+}
+
+// findReplicationGroupAutoScaling returns the auto_scaling block for the replication group's
+// scalable target in the given dimension.
+func findReplicationGroupAutoScaling(conn *applicationautoscaling.ApplicationAutoScaling, id, dimension string) ([]interface{}, error) {
+	target, err := FindReplicationGroupScalableTarget(conn, id, dimension)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap := map[string]interface{}{
+		"max_capacity":       aws.Int64Value(target.MaxCapacity),
+		"min_capacity":       aws.Int64Value(target.MinCapacity),
+		"scalable_dimension": dimension,
+	}
+
+	policy, err := FindReplicationGroupScalingPolicy(conn, id, dimension, replicationGroupAutoScalingPolicyName(id, dimension))
+
+	if err != nil && !tfresource.NotFound(err) {
+		return nil, err
+	}
+
+	if policy != nil {
+		tfMap["target_tracking"] = flattenReplicationGroupTargetTrackingScalingPolicyConfiguration(policy.TargetTrackingScalingPolicyConfiguration)
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+func replicationGroupAutoScalingDimension(tfList []interface{}) string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return ""
+	}
+
+	return tfList[0].(map[string]interface{})["scalable_dimension"].(string)
+}
+
+func replicationGroupAutoScalingResourceID(id string) string {
+	return fmt.Sprintf("replication-group/%s", id)
+}
+
+func replicationGroupAutoScalingPolicyName(id, dimension string) string {
+	return fmt.Sprintf("%s-%s", id, strings.ToLower(strings.TrimPrefix(dimension, "elasticache:replication-group:")))
+}
+
+func replicationGroupScalableDimension_Values() []string {
+	return []string{
+		applicationautoscaling.ScalableDimensionElasticacheReplicationGroupNodeGroups,
+		applicationautoscaling.ScalableDimensionElasticacheReplicationGroupReplicas,
+	}
+}
+
+func replicationGroupPredefinedMetricType_Values() []string {
+	return []string{
+		applicationautoscaling.MetricTypeElastiCacheDatabaseMemoryUsageCountedForEvictPercentage,
+		applicationautoscaling.MetricTypeElastiCachePrimaryEngineCpuutilization,
+		applicationautoscaling.MetricTypeElastiCacheReplicaEngineCpuutilization,
+	}
+}
+
 func flattenElasticacheNodeGroupsToClusterMode(nodeGroups []*elasticache.NodeGroup) []map[string]interface{} {
 	if len(nodeGroups) == 0 {
 		return []map[string]interface{}{}
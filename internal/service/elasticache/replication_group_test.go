@@ -1752,6 +1752,272 @@ func TestAccElastiCacheReplicationGroup_dataTiering(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheReplicationGroup_logDeliveryConfiguration(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationGroupConfig_LogDeliveryConfiguration(rName, true, "text"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"destination":      rName,
+						"destination_type": "cloudwatch-logs",
+						"log_format":       "text",
+						"log_type":         "slow-log",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+			{
+				Config: testAccReplicationGroupConfig_LogDeliveryConfiguration(rName, true, "json"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "log_delivery_configuration.*", map[string]string{
+						"log_format": "json",
+						"log_type":   "slow-log",
+					}),
+				),
+			},
+			{
+				Config: testAccReplicationGroupConfig_LogDeliveryConfiguration(rName, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheReplicationGroup_transitEncryptionMode(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	var c1, c2, c3 map[string]*elasticache.CacheCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationGroupConfig_TransitEncryption(rName, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					testAccCheckReplicationGroupMemberClusters(resourceName, &c1),
+					resource.TestCheckResourceAttr(resourceName, "transit_encryption_enabled", "false"),
+				),
+			},
+			{
+				Config:      testAccReplicationGroupConfig_TransitEncryption(rName, true, "required"),
+				ExpectError: regexp.MustCompile(`transit_encryption_mode must be "preferred" when enabling in-transit encryption`),
+			},
+			{
+				// transit_encryption_mode defaults to "preferred" when enabling in-transit encryption in place.
+				Config: testAccReplicationGroupConfig_TransitEncryption(rName, true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					testAccCheckReplicationGroupMemberClusters(resourceName, &c2),
+					testAccCheckReplicationGroupNotRecreated(&c1, &c2),
+					resource.TestCheckResourceAttr(resourceName, "transit_encryption_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transit_encryption_mode", "preferred"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+			{
+				Config: testAccReplicationGroupConfig_TransitEncryption(rName, true, "required"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					testAccCheckReplicationGroupMemberClusters(resourceName, &c3),
+					testAccCheckReplicationGroupNotRecreated(&c2, &c3),
+					resource.TestCheckResourceAttr(resourceName, "transit_encryption_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transit_encryption_mode", "required"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheReplicationGroup_authTokenUpdateStrategy(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationGroupConfig_AuthTokenUpdateStrategy(rName, sdkacctest.RandString(16), "ROTATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "auth_token_update_strategy", "ROTATE"),
+				),
+			},
+			{
+				Config: testAccReplicationGroupConfig_AuthTokenUpdateStrategy(rName, sdkacctest.RandString(16), "SET"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "auth_token_update_strategy", "SET"),
+				),
+			},
+			{
+				Config:      testAccReplicationGroupConfig_AuthTokenRemoved(rName, "ROTATE"),
+				ExpectError: regexp.MustCompile(`auth_token_update_strategy must be "DELETE" to remove auth_token`),
+			},
+			{
+				Config: testAccReplicationGroupConfig_AuthTokenRemoved(rName, "DELETE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "auth_token_update_strategy", "DELETE"),
+					resource.TestCheckResourceAttr(resourceName, "auth_token", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheReplicationGroup_autoScaling(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationGroupConfig_AutoScaling(rName, "elasticache:replication-group:NodeGroups", "ElastiCachePrimaryEngineCPUUtilization", 2, 4, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.scalable_dimension", "elasticache:replication-group:NodeGroups"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.min_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.max_capacity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.target_tracking.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.target_tracking.0.disable_scale_in", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.target_tracking.0.predefined_metric_type", "ElastiCachePrimaryEngineCPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.target_tracking.0.target_value", "60"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccReplicationGroupImportStateAutoScalingIDFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+			{
+				Config: testAccReplicationGroupConfig_AutoScaling(rName, "elasticache:replication-group:Replicas", "ElastiCacheReplicaEngineCPUUtilization", 1, 3, 70),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.scalable_dimension", "elasticache:replication-group:Replicas"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.max_capacity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.target_tracking.0.predefined_metric_type", "ElastiCacheReplicaEngineCPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.target_tracking.0.target_value", "70"),
+				),
+			},
+			{
+				Config: testAccReplicationGroupConfig_AutoScalingRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationGroupExists(resourceName, &rg),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestReplicationGroupParseImportID(t *testing.T) {
+	cases := []struct {
+		Name              string
+		ID                string
+		ExpectedID        string
+		ExpectedDimension string
+		ExpectedError     bool
+	}{
+		{
+			Name:       "replication group ID",
+			ID:         "tf-redis",
+			ExpectedID: "tf-redis",
+		},
+		{
+			Name:              "replication group ID and scalable dimension",
+			ID:                "tf-redis/elasticache:replication-group:Replicas",
+			ExpectedID:        "tf-redis",
+			ExpectedDimension: "elasticache:replication-group:Replicas",
+		},
+		{
+			Name:          "invalid scalable dimension",
+			ID:            "tf-redis/dynamodb:table:ReadCapacityUnits",
+			ExpectedError: true,
+		},
+		{
+			Name:          "empty replication group ID",
+			ID:            "/elasticache:replication-group:NodeGroups",
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		id, dimension, err := tfelasticache.ReplicationGroupParseImportID(tc.ID)
+
+		if tc.ExpectedError {
+			if err == nil {
+				t.Errorf("Case %q: expected error, got none", tc.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Case %q: unexpected error: %s", tc.Name, err)
+			continue
+		}
+
+		if id != tc.ExpectedID || dimension != tc.ExpectedDimension {
+			t.Errorf("Case %q: expected (%q, %q), got (%q, %q)", tc.Name, tc.ExpectedID, tc.ExpectedDimension, id, dimension)
+		}
+	}
+}
+
+func testAccReplicationGroupImportStateAutoScalingIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["auto_scaling.0.scalable_dimension"]), nil
+	}
+}
+
 func testAccCheckReplicationGroupExists(n string, v *elasticache.ReplicationGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -3234,3 +3500,129 @@ func resourceReplicationGroupModify(conn *elasticache.ElastiCache, timeout time.
 func formatReplicationGroupClusterID(replicationGroupID string, clusterID int) string {
 	return fmt.Sprintf("%s-%03d", replicationGroupID, clusterID)
 }
+
+func testAccReplicationGroupConfig_TransitEncryption(rName string, enabled bool, mode string) string {
+	var transitEncryptionMode string
+	if mode != "" {
+		transitEncryptionMode = fmt.Sprintf("transit_encryption_mode       = %q", mode)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  engine_version                = "7.x"
+  number_cache_clusters         = 1
+  apply_immediately             = true
+  transit_encryption_enabled    = %[2]t
+  %[3]s
+}
+`, rName, enabled, transitEncryptionMode)
+}
+
+func testAccReplicationGroupConfig_AuthTokenUpdateStrategy(rName, authToken, strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  number_cache_clusters         = 1
+  apply_immediately             = true
+  transit_encryption_enabled    = true
+  auth_token                    = %[2]q
+  auth_token_update_strategy    = %[3]q
+}
+`, rName, authToken, strategy)
+}
+
+func testAccReplicationGroupConfig_AuthTokenRemoved(rName, strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  number_cache_clusters         = 1
+  apply_immediately             = true
+  transit_encryption_enabled    = true
+  auth_token_update_strategy    = %[2]q
+}
+`, rName, strategy)
+}
+
+func testAccReplicationGroupConfig_AutoScaling(rName, dimension, metricType string, minCapacity, maxCapacity int, targetValue float64) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.r6g.large"
+  engine_version                = "6.x"
+  parameter_group_name          = "default.redis6.x.cluster.on"
+  automatic_failover_enabled    = true
+  apply_immediately             = true
+
+  cluster_mode {
+    num_node_groups         = 2
+    replicas_per_node_group = 1
+  }
+
+  auto_scaling {
+    scalable_dimension = %[2]q
+    min_capacity       = %[4]d
+    max_capacity       = %[5]d
+
+    target_tracking {
+      predefined_metric_type = %[3]q
+      target_value           = %[6]g
+    }
+  }
+}
+`, rName, dimension, metricType, minCapacity, maxCapacity, targetValue)
+}
+
+func testAccReplicationGroupConfig_AutoScalingRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.r6g.large"
+  engine_version                = "6.x"
+  parameter_group_name          = "default.redis6.x.cluster.on"
+  automatic_failover_enabled    = true
+  apply_immediately             = true
+
+  cluster_mode {
+    num_node_groups         = 2
+    replicas_per_node_group = 1
+  }
+}
+`, rName)
+}
+
+func testAccReplicationGroupConfig_LogDeliveryConfiguration(rName string, enabled bool, logFormat string) string {
+	var logDeliveryConfiguration string
+	if enabled {
+		logDeliveryConfiguration = fmt.Sprintf(`
+  log_delivery_configuration {
+    destination      = aws_cloudwatch_log_group.test.name
+    destination_type = "cloudwatch-logs"
+    log_format       = %q
+    log_type         = "slow-log"
+  }
+`, logFormat)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  apply_immediately             = true
+%[2]s
+}
+`, rName, logDeliveryConfiguration)
+}
@@ -0,0 +1,239 @@
+package elasticache
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReservedCacheNode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReservedCacheNodeCreate,
+		Read:   resourceReservedCacheNodeRead,
+		Update: resourceReservedCacheNodeUpdate,
+		Delete: resourceReservedCacheNodeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ReservedCacheNodeActiveTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"reserved_cache_nodes_offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReservedCacheNodeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &elasticache.PurchaseReservedCacheNodesOfferingInput{
+		CacheNodeCount:               aws.Int64(int64(d.Get("cache_node_count").(int))),
+		ReservedCacheNodesOfferingId: aws.String(d.Get("reserved_cache_nodes_offering_id").(string)),
+	}
+
+	if v, ok := d.GetOk("reservation_id"); ok {
+		input.ReservedCacheNodeId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Purchasing ElastiCache Reserved Cache Node: %s", input)
+	output, err := conn.PurchaseReservedCacheNodesOffering(input)
+
+	if err != nil {
+		return fmt.Errorf("error purchasing ElastiCache Reserved Cache Node offering (%s): %w", d.Get("reserved_cache_nodes_offering_id").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.ReservedCacheNode.ReservedCacheNodeId))
+
+	if _, err := WaitReservedCacheNodeActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Reserved Cache Node (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceReservedCacheNodeRead(d, meta)
+}
+
+func resourceReservedCacheNodeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	reservation, err := FindReservedCacheNodeByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Reserved Cache Node (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(reservation.ReservationARN)
+	d.Set("arn", arn)
+	d.Set("cache_node_count", reservation.CacheNodeCount)
+	d.Set("cache_node_type", reservation.CacheNodeType)
+	d.Set("duration", reservation.Duration)
+	d.Set("fixed_price", reservation.FixedPrice)
+	d.Set("offering_type", reservation.OfferingType)
+	d.Set("product_description", reservation.ProductDescription)
+	if err := d.Set("recurring_charges", flattenRecurringCharges(reservation.RecurringCharges)); err != nil {
+		return fmt.Errorf("error setting recurring_charges: %w", err)
+	}
+	d.Set("reservation_id", reservation.ReservedCacheNodeId)
+	d.Set("reserved_cache_nodes_offering_id", reservation.ReservedCacheNodesOfferingId)
+	if reservation.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(reservation.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("state", reservation.State)
+	d.Set("usage_price", reservation.UsagePrice)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for ElastiCache Reserved Cache Node (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceReservedCacheNodeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating ElastiCache Reserved Cache Node (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceReservedCacheNodeRead(d, meta)
+}
+
+func resourceReservedCacheNodeDelete(d *schema.ResourceData, meta interface{}) error {
+	// Reservations cannot be cancelled. They expire at the end of their term.
+	log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func flattenRecurringCharges(apiObjects []*elasticache.RecurringCharge) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"recurring_charge_amount":    aws.Float64Value(apiObject.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.StringValue(apiObject.RecurringChargeFrequency),
+		})
+	}
+
+	return tfList
+}
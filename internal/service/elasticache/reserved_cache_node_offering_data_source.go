@@ -0,0 +1,77 @@
+package elasticache
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceReservedCacheNodeOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReservedCacheNodeOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "3", "31536000", "94608000"}, false),
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(reservedCacheNodeOfferingType_Values(), false),
+			},
+			"product_description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(engine_Values(), false),
+			},
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedCacheNodeOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	input := &elasticache.DescribeReservedCacheNodesOfferingsInput{
+		CacheNodeType:      aws.String(d.Get("cache_node_type").(string)),
+		Duration:           aws.String(d.Get("duration").(string)),
+		OfferingType:       aws.String(d.Get("offering_type").(string)),
+		ProductDescription: aws.String(d.Get("product_description").(string)),
+	}
+
+	offering, err := FindReservedCacheNodesOffering(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("ElastiCache Reserved Cache Node Offering", err)
+	}
+
+	d.SetId(aws.StringValue(offering.ReservedCacheNodesOfferingId))
+	d.Set("cache_node_type", offering.CacheNodeType)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set("offering_id", offering.ReservedCacheNodesOfferingId)
+	d.Set("offering_type", offering.OfferingType)
+	d.Set("product_description", offering.ProductDescription)
+	d.Set("usage_price", offering.UsagePrice)
+
+	return nil
+}
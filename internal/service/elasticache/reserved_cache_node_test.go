@@ -0,0 +1,101 @@
+package elasticache_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+)
+
+func TestAccElastiCacheReservedCacheNode_basic(t *testing.T) {
+	// Reservations cannot be cancelled and are billed for their full term.
+	if os.Getenv("RUN_ELASTICACHE_RESERVED_CACHE_NODE_TEST") == "" {
+		t.Skip("Environment variable RUN_ELASTICACHE_RESERVED_CACHE_NODE_TEST is not set")
+	}
+
+	var reservation elasticache.ReservedCacheNode
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_reserved_cache_node.test"
+	dataSourceName := "data.aws_elasticache_reserved_cache_node_offering.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedCacheNodeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservedCacheNodeExists(resourceName, &reservation),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "elasticache", regexp.MustCompile(`reserved-instance:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cache_node_count", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cache_node_type", dataSourceName, "cache_node_type"),
+					resource.TestCheckResourceAttr(resourceName, "duration", "31536000"),
+					resource.TestCheckResourceAttrPair(resourceName, "fixed_price", dataSourceName, "fixed_price"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_type", dataSourceName, "offering_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_description", dataSourceName, "product_description"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "reserved_cache_nodes_offering_id", dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReservedCacheNodeExists(n string, v *elasticache.ReservedCacheNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache Reserved Cache Node ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+
+		output, err := tfelasticache.FindReservedCacheNodeByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReservedCacheNodeConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_elasticache_reserved_cache_node_offering" "test" {
+  cache_node_type     = "cache.t3.micro"
+  duration            = "31536000"
+  offering_type       = "No Upfront"
+  product_description = "redis"
+}
+
+resource "aws_elasticache_reserved_cache_node" "test" {
+  reserved_cache_nodes_offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  reservation_id                   = %[1]q
+  cache_node_count                 = 1
+}
+`, rName)
+}
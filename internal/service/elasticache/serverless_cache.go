@@ -0,0 +1,502 @@
+package elasticache
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServerlessCache() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServerlessCacheCreate,
+		Read:   resourceServerlessCacheRead,
+		Update: resourceServerlessCacheUpdate,
+		Delete: resourceServerlessCacheDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ServerlessCacheDefaultCreatedTimeout),
+			Update: schema.DefaultTimeout(ServerlessCacheDefaultUpdatedTimeout),
+			Delete: schema.DefaultTimeout(ServerlessCacheDefaultDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_usage_limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_storage": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(elasticache.DataStorageUnit_Values(), false),
+									},
+								},
+							},
+						},
+						"ecpu_per_second": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1000),
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"daily_snapshot_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9]$`), "must be in the format HH:MM"),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"endpoint": serverlessCacheEndpointSchema(),
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(serverlessCacheEngine_Values(), false),
+			},
+			"full_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 40),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]*$`), "must begin with a lowercase letter and contain only lowercase alphanumeric characters and hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`--|-$`), "cannot contain two consecutive hyphens or end with a hyphen"),
+				),
+			},
+			"reader_endpoint": serverlessCacheEndpointSchema(),
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"snapshot_arns_to_restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 35),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func serverlessCacheEndpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceServerlessCacheCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &elasticache.CreateServerlessCacheInput{
+		Engine:              aws.String(d.Get("engine").(string)),
+		ServerlessCacheName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("cache_usage_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CacheUsageLimits = expandServerlessCacheUsageLimits(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("daily_snapshot_time"); ok {
+		input.DailySnapshotTime = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_engine_version"); ok {
+		input.MajorEngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_arns_to_restore"); ok && len(v.([]interface{})) > 0 {
+		input.SnapshotArnsToRestore = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("user_group_id"); ok {
+		input.UserGroupId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating ElastiCache Serverless Cache: %s", input)
+	_, err := conn.CreateServerlessCache(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ElastiCache Serverless Cache (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := WaitServerlessCacheAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Serverless Cache (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceServerlessCacheRead(d, meta)
+}
+
+func resourceServerlessCacheRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cache, err := FindServerlessCacheByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Serverless Cache (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Serverless Cache (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(cache.ARN)
+	d.Set("arn", arn)
+	if err := d.Set("cache_usage_limits", flattenServerlessCacheUsageLimits(cache.CacheUsageLimits)); err != nil {
+		return fmt.Errorf("error setting cache_usage_limits: %w", err)
+	}
+	if cache.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(cache.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("daily_snapshot_time", cache.DailySnapshotTime)
+	d.Set("description", cache.Description)
+	if err := d.Set("endpoint", flattenServerlessCacheEndpoint(cache.Endpoint)); err != nil {
+		return fmt.Errorf("error setting endpoint: %w", err)
+	}
+	d.Set("engine", cache.Engine)
+	d.Set("full_engine_version", cache.FullEngineVersion)
+	d.Set("kms_key_id", cache.KmsKeyId)
+	d.Set("major_engine_version", cache.MajorEngineVersion)
+	d.Set("name", cache.ServerlessCacheName)
+	if err := d.Set("reader_endpoint", flattenServerlessCacheEndpoint(cache.ReaderEndpoint)); err != nil {
+		return fmt.Errorf("error setting reader_endpoint: %w", err)
+	}
+	d.Set("security_group_ids", aws.StringValueSlice(cache.SecurityGroupIds))
+	d.Set("snapshot_retention_limit", cache.SnapshotRetentionLimit)
+	d.Set("status", cache.Status)
+	d.Set("subnet_ids", aws.StringValueSlice(cache.SubnetIds))
+	d.Set("user_group_id", cache.UserGroupId)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for ElastiCache Serverless Cache (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServerlessCacheUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &elasticache.ModifyServerlessCacheInput{
+			ServerlessCacheName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("cache_usage_limits") {
+			input.CacheUsageLimits = &elasticache.CacheUsageLimits{}
+
+			if v, ok := d.GetOk("cache_usage_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CacheUsageLimits = expandServerlessCacheUsageLimits(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("daily_snapshot_time") {
+			input.DailySnapshotTime = aws.String(d.Get("daily_snapshot_time").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("security_group_ids") {
+			input.SecurityGroupIds = flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set))
+		}
+
+		if d.HasChange("snapshot_retention_limit") {
+			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		}
+
+		if d.HasChange("user_group_id") {
+			if v, ok := d.GetOk("user_group_id"); ok {
+				input.UserGroupId = aws.String(v.(string))
+			} else {
+				input.RemoveUserGroup = aws.Bool(true)
+			}
+		}
+
+		log.Printf("[DEBUG] Modifying ElastiCache Serverless Cache: %s", input)
+		_, err := conn.ModifyServerlessCache(input)
+
+		if err != nil {
+			return fmt.Errorf("error modifying ElastiCache Serverless Cache (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitServerlessCacheAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache Serverless Cache (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating ElastiCache Serverless Cache (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServerlessCacheRead(d, meta)
+}
+
+func resourceServerlessCacheDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	log.Printf("[DEBUG] Deleting ElastiCache Serverless Cache: %s", d.Id())
+	_, err := conn.DeleteServerlessCache(&elasticache.DeleteServerlessCacheInput{
+		ServerlessCacheName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeServerlessCacheNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ElastiCache Serverless Cache (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitServerlessCacheDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Serverless Cache (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServerlessCacheUsageLimits(tfMap map[string]interface{}) *elasticache.CacheUsageLimits {
+	apiObject := &elasticache.CacheUsageLimits{}
+
+	if v, ok := tfMap["data_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		dataStorage := &elasticache.DataStorage{
+			Unit: aws.String(tfMap["unit"].(string)),
+		}
+
+		if v, ok := tfMap["maximum"].(int); ok && v != 0 {
+			dataStorage.Maximum = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["minimum"].(int); ok && v != 0 {
+			dataStorage.Minimum = aws.Int64(int64(v))
+		}
+
+		apiObject.DataStorage = dataStorage
+	}
+
+	if v, ok := tfMap["ecpu_per_second"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		ecpuPerSecond := &elasticache.ECPUPerSecond{}
+
+		if v, ok := tfMap["maximum"].(int); ok && v != 0 {
+			ecpuPerSecond.Maximum = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["minimum"].(int); ok && v != 0 {
+			ecpuPerSecond.Minimum = aws.Int64(int64(v))
+		}
+
+		apiObject.ECPUPerSecond = ecpuPerSecond
+	}
+
+	return apiObject
+}
+
+func flattenServerlessCacheUsageLimits(apiObject *elasticache.CacheUsageLimits) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataStorage; v != nil {
+		tfMap["data_storage"] = []interface{}{map[string]interface{}{
+			"maximum": aws.Int64Value(v.Maximum),
+			"minimum": aws.Int64Value(v.Minimum),
+			"unit":    aws.StringValue(v.Unit),
+		}}
+	}
+
+	if v := apiObject.ECPUPerSecond; v != nil {
+		tfMap["ecpu_per_second"] = []interface{}{map[string]interface{}{
+			"maximum": aws.Int64Value(v.Maximum),
+			"minimum": aws.Int64Value(v.Minimum),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServerlessCacheEndpoint(apiObject *elasticache.Endpoint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"address": aws.StringValue(apiObject.Address),
+		"port":    aws.Int64Value(apiObject.Port),
+	}}
+}
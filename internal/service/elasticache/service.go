@@ -3,6 +3,7 @@ package elasticache
 const (
 	engineMemcached = "memcached"
 	engineRedis     = "redis"
+	engineValkey    = "valkey"
 )
 
 // engine_Values returns all elements of the Engine enum
@@ -12,3 +13,33 @@ func engine_Values() []string {
 		engineRedis,
 	}
 }
+
+// serverlessCacheEngine_Values returns all elements of the serverless cache Engine enum
+func serverlessCacheEngine_Values() []string {
+	return []string{
+		engineMemcached,
+		engineRedis,
+		engineValkey,
+	}
+}
+
+const (
+	reservedCacheNodeOfferingTypeAllUpfront        = "All Upfront"
+	reservedCacheNodeOfferingTypeHeavyUtilization  = "Heavy Utilization"
+	reservedCacheNodeOfferingTypeLightUtilization  = "Light Utilization"
+	reservedCacheNodeOfferingTypeMediumUtilization = "Medium Utilization"
+	reservedCacheNodeOfferingTypeNoUpfront         = "No Upfront"
+	reservedCacheNodeOfferingTypePartialUpfront    = "Partial Upfront"
+)
+
+// reservedCacheNodeOfferingType_Values returns all elements of the reserved cache node OfferingType enum
+func reservedCacheNodeOfferingType_Values() []string {
+	return []string{
+		reservedCacheNodeOfferingTypeAllUpfront,
+		reservedCacheNodeOfferingTypeHeavyUtilization,
+		reservedCacheNodeOfferingTypeLightUtilization,
+		reservedCacheNodeOfferingTypeMediumUtilization,
+		reservedCacheNodeOfferingTypeNoUpfront,
+		reservedCacheNodeOfferingTypePartialUpfront,
+	}
+}
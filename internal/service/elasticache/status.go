@@ -15,6 +15,9 @@ const (
 	ReplicationGroupStatusCreateFailed = "create-failed"
 	ReplicationGroupStatusSnapshotting = "snapshotting"
 
+	ReservedCacheNodeStateActive         = "active"
+	ReservedCacheNodeStatePaymentPending = "payment-pending"
+
 	UserStatusActive    = "active"
 	UserStatusDeleting  = "deleting"
 	UserStatusModifying = "modifying"
@@ -129,6 +132,21 @@ func StatusGlobalReplicationGroupMember(conn *elasticache.ElastiCache, globalRep
 	}
 }
 
+// StatusGlobalReplicationGroupMemberRole fetches the Global Replication Group member and its Role
+func StatusGlobalReplicationGroupMemberRole(conn *elasticache.ElastiCache, globalReplicationGroupID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := FindGlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return member, aws.StringValue(member.Role), nil
+	}
+}
+
 // StatusUser fetches the ElastiCache user and its Status
 func StatusUser(conn *elasticache.ElastiCache, userId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -145,3 +163,40 @@ func StatusUser(conn *elasticache.ElastiCache, userId string) resource.StateRefr
 		return user, aws.StringValue(user.Status), nil
 	}
 }
+
+// StatusReservedCacheNode fetches the Reserved Cache Node and its State
+func StatusReservedCacheNode(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		reservation, err := FindReservedCacheNodeByID(conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return reservation, aws.StringValue(reservation.State), nil
+	}
+}
+
+const (
+	ServerlessCacheStatusAvailable = "available"
+	ServerlessCacheStatusCreating  = "creating"
+	ServerlessCacheStatusDeleting  = "deleting"
+	ServerlessCacheStatusModifying = "modifying"
+)
+
+// StatusServerlessCache fetches the Serverless Cache and its Status
+func StatusServerlessCache(conn *elasticache.ElastiCache, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cache, err := FindServerlessCacheByName(conn, name)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cache, aws.StringValue(cache.Status), nil
+	}
+}
@@ -3,6 +3,9 @@ package elasticache
 import (
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 const (
@@ -12,6 +15,12 @@ const (
 
 var versionStringRegexp = regexp.MustCompile(versionStringRegexpPattern)
 
+// parameterGroupNameMaxLength is the maximum length of a cache parameter group name.
+const parameterGroupNameMaxLength = 255
+
+// parameterValueMaxLength is the maximum length of a cache parameter value.
+const parameterValueMaxLength = 4096
+
 func validReplicationGroupAuthToken(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if (len(value) < 16) || (len(value) > 128) {
@@ -34,3 +43,76 @@ func validVersionString(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+func validParameterName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return
+	}
+
+	if strings.TrimSpace(value) != value {
+		errors = append(errors, fmt.Errorf("%q cannot contain leading or trailing whitespace: %q", k, value))
+	}
+
+	return
+}
+
+func validParameterValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+	}
+
+	if len(value) > parameterValueMaxLength {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters", k, parameterValueMaxLength))
+	}
+
+	return
+}
+
+func validParameterGroupName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 || len(value) > parameterGroupNameMaxLength {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and %d characters in length: %q", k, parameterGroupNameMaxLength, value))
+		return
+	}
+	if !regexp.MustCompile(`^[a-zA-Z]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("first character of %q must be a letter: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[0-9a-zA-Z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters and hyphens allowed in %q: %q", k, value))
+	}
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain two consecutive hyphens: %q", k, value))
+	}
+	if regexp.MustCompile(`-$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot end with a hyphen: %q", k, value))
+	}
+
+	return
+}
+
+func validParameterGroupNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// The generated name must not exceed the maximum parameter group name length.
+	if maxLength := parameterGroupNameMaxLength - resource.UniqueIDSuffixLength; len(value) < 1 || len(value) > maxLength {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and %d characters in length so the generated name does not exceed %d characters: %q", k, maxLength, parameterGroupNameMaxLength, value))
+		return
+	}
+	if !regexp.MustCompile(`^[a-zA-Z]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("first character of %q must be a letter: %q", k, value))
+	}
+	if !regexp.MustCompile(`^[0-9a-zA-Z-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters and hyphens allowed in %q: %q", k, value))
+	}
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain two consecutive hyphens: %q", k, value))
+	}
+
+	return
+}
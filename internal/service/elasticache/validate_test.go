@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestValidReplicationGroupAuthToken(t *testing.T) {
@@ -45,3 +46,163 @@ func TestValidReplicationGroupAuthToken(t *testing.T) {
 		}
 	}
 }
+
+func TestValidParameterName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "maxmemory-policy",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    " maxmemory-policy",
+			ErrCount: 1,
+		},
+		{
+			Value:    "maxmemory-policy\t",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validParameterName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidParameterValue(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "allkeys-lru",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(parameterValueMaxLength, sdkacctest.CharSetAlpha),
+			ErrCount: 0,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(parameterValueMaxLength+1, sdkacctest.CharSetAlpha),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validParameterValue(tc.Value, "value")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidParameterGroupName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "tf-test-parameter-group",
+			ErrCount: 0,
+		},
+		{
+			Value:    "TF-ELASTIPG-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(parameterGroupNameMaxLength, sdkacctest.CharSetAlpha),
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(parameterGroupNameMaxLength+1, sdkacctest.CharSetAlpha),
+			ErrCount: 1,
+		},
+		{
+			Value:    "1-parameter-group",
+			ErrCount: 1,
+		},
+		{
+			Value:    "parameter_group",
+			ErrCount: 1,
+		},
+		{
+			Value:    "parameter--group",
+			ErrCount: 1,
+		},
+		{
+			Value:    "parameter-group-",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validParameterGroupName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidParameterGroupNamePrefix(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "tf-test-",
+			ErrCount: 0,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(parameterGroupNameMaxLength-resource.UniqueIDSuffixLength, sdkacctest.CharSetAlpha),
+			ErrCount: 0,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(parameterGroupNameMaxLength-resource.UniqueIDSuffixLength+1, sdkacctest.CharSetAlpha),
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1-tf-test-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "tf_test",
+			ErrCount: 1,
+		},
+		{
+			Value:    "tf--test",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validParameterGroupNamePrefix(tc.Value, "name_prefix")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -72,6 +72,48 @@ func CustomizeDiffElastiCacheEngineVersion(_ context.Context, diff *schema.Resou
 	return diff.ForceNew("engine_version")
 }
 
+// minimumTransitEncryptionModifyVersion is the earliest Redis engine version that supports
+// enabling or disabling in-transit encryption on an existing replication group.
+var minimumTransitEncryptionModifyVersion = gversion.Must(gversion.NewVersion("7.0.5"))
+
+// CustomizeDiffReplicationGroupTransitEncryption causes re-creation of the resource if `transit_encryption_enabled`
+// is changed and the actual engine version does not support modifying in-transit encryption, and validates
+// that `transit_encryption_mode` is "preferred" when in-transit encryption is enabled in place
+func CustomizeDiffReplicationGroupTransitEncryption(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("transit_encryption_enabled") {
+		return nil
+	}
+
+	version, err := gversion.NewVersion(diff.Get("engine_version_actual").(string))
+	if err != nil || version.LessThan(minimumTransitEncryptionModifyVersion) {
+		return diff.ForceNew("transit_encryption_enabled")
+	}
+
+	if diff.Get("transit_encryption_enabled").(bool) && diff.Get("transit_encryption_mode").(string) == elasticache.TransitEncryptionModeRequired {
+		return fmt.Errorf(`transit_encryption_mode must be %q when enabling in-transit encryption on an existing replication group`, elasticache.TransitEncryptionModePreferred)
+	}
+
+	return nil
+}
+
+// CustomizeDiffValidateReplicationGroupAuthTokenUpdateStrategy validates that `auth_token_update_strategy` is "DELETE"
+// when `auth_token` is removed from an existing replication group
+func CustomizeDiffValidateReplicationGroupAuthTokenUpdateStrategy(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("auth_token") {
+		return nil
+	}
+
+	if o, n := diff.GetChange("auth_token"); o.(string) == "" || n.(string) != "" {
+		return nil
+	}
+
+	if diff.Get("auth_token_update_strategy").(string) != elasticache.AuthTokenUpdateStrategyTypeDelete {
+		return fmt.Errorf(`auth_token_update_strategy must be %q to remove auth_token`, elasticache.AuthTokenUpdateStrategyTypeDelete)
+	}
+
+	return nil
+}
+
 // CustomizeDiffValidateClusterAZMode validates that `num_cache_nodes` is greater than 1 when `az_mode` is "cross-az"
 func CustomizeDiffValidateClusterAZMode(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	if v, ok := diff.GetOk("az_mode"); !ok || v.(string) != elasticache.AZModeCrossAz {
@@ -18,6 +18,8 @@ const (
 	replicationGroupDeletedMinTimeout = 10 * time.Second
 	replicationGroupDeletedDelay      = 30 * time.Second
 
+	ReservedCacheNodeActiveTimeout = 5 * time.Minute
+
 	UserActiveTimeout  = 5 * time.Minute
 	UserDeletedTimeout = 5 * time.Minute
 )
@@ -234,6 +236,24 @@ func WaitGlobalReplicationGroupMemberDetached(conn *elasticache.ElastiCache, glo
 	return nil, err
 }
 
+// WaitGlobalReplicationGroupMemberPrimary waits for a Global Replication Group member to become the primary
+func WaitGlobalReplicationGroupMemberPrimary(conn *elasticache.ElastiCache, globalReplicationGroupID, id string, timeout time.Duration) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupMemberRoleSecondary},
+		Target:     []string{GlobalReplicationGroupMemberRolePrimary},
+		Refresh:    StatusGlobalReplicationGroupMemberRole(conn, globalReplicationGroupID, id),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupAvailableMinTimeout,
+		Delay:      globalReplicationGroupAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+	return nil, err
+}
+
 // WaitUserActive waits for an ElastiCache user to reach an active state after modifications
 func WaitUserActive(conn *elasticache.ElastiCache, userId string) error {
 	stateConf := &resource.StateChangeConf{
@@ -261,3 +281,61 @@ func WaitUserDeleted(conn *elasticache.ElastiCache, userId string) error {
 
 	return err
 }
+
+// WaitReservedCacheNodeActive waits for a Reserved Cache Node to return Active
+func WaitReservedCacheNodeActive(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ReservedCacheNode, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ReservedCacheNodeStatePaymentPending},
+		Target:  []string{ReservedCacheNodeStateActive},
+		Refresh: StatusReservedCacheNode(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.ReservedCacheNode); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+const (
+	ServerlessCacheDefaultCreatedTimeout = 40 * time.Minute
+	ServerlessCacheDefaultUpdatedTimeout = 40 * time.Minute
+	ServerlessCacheDefaultDeletedTimeout = 40 * time.Minute
+)
+
+// WaitServerlessCacheAvailable waits for a Serverless Cache to return Available
+func WaitServerlessCacheAvailable(conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ServerlessCacheStatusCreating, ServerlessCacheStatusModifying},
+		Target:     []string{ServerlessCacheStatusAvailable},
+		Refresh:    StatusServerlessCache(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// WaitServerlessCacheDeleted waits for a Serverless Cache to be deleted
+func WaitServerlessCacheDeleted(conn *elasticache.ElastiCache, name string, timeout time.Duration) (*elasticache.ServerlessCache, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ServerlessCacheStatusAvailable, ServerlessCacheStatusDeleting},
+		Target:     []string{},
+		Refresh:    StatusServerlessCache(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.ServerlessCache); ok {
+		return v, err
+	}
+	return nil, err
+}
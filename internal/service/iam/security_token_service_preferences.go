@@ -0,0 +1,74 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceSecurityTokenServicePreferences() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityTokenServicePreferencesUpsert,
+		Read:   resourceSecurityTokenServicePreferencesRead,
+		Update: resourceSecurityTokenServicePreferencesUpsert,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_endpoint_token_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(iam.GlobalEndpointTokenVersion_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceSecurityTokenServicePreferencesUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	input := &iam.SetSecurityTokenServicePreferencesInput{
+		GlobalEndpointTokenVersion: aws.String(d.Get("global_endpoint_token_version").(string)),
+	}
+
+	log.Printf("[DEBUG] Setting IAM Security Token Service Preferences: %s", input)
+	_, err := conn.SetSecurityTokenServicePreferences(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting IAM Security Token Service Preferences: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceSecurityTokenServicePreferencesRead(d, meta)
+}
+
+func resourceSecurityTokenServicePreferencesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	output, err := conn.GetAccountSummary(&iam.GetAccountSummaryInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Security Token Service Preferences (%s): %w", d.Id(), err)
+	}
+
+	// The account summary reports the token version as an integer.
+	switch v := aws.Int64Value(output.SummaryMap[iam.SummaryKeyTypeGlobalEndpointTokenVersion]); v {
+	case 1:
+		d.Set("global_endpoint_token_version", iam.GlobalEndpointTokenVersionV1token)
+	case 2:
+		d.Set("global_endpoint_token_version", iam.GlobalEndpointTokenVersionV2token)
+	default:
+		return fmt.Errorf("error reading IAM Security Token Service Preferences (%s): unexpected global endpoint token version: %d", d.Id(), v)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package iam
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVirtualMFADevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVirtualMFADeviceCreate,
+		Read:   resourceVirtualMFADeviceRead,
+		Update: resourceVirtualMFADeviceUpdate,
+		Delete: resourceVirtualMFADeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_32_string_seed": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+				ForceNew: true,
+			},
+			"qr_code_png": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"virtual_mfa_device_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 226),
+					validation.StringMatch(regexp.MustCompile(`^[\w+=,.@-]+$`), "must consist of upper and lowercase alphanumeric characters with no spaces. You can also include any of the following characters: _+=,.@-"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVirtualMFADeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("virtual_mfa_device_name").(string)
+	input := &iam.CreateVirtualMFADeviceInput{
+		Path:                 aws.String(d.Get("path").(string)),
+		VirtualMFADeviceName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateVirtualMFADevice(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Virtual MFA Device (%s): %w", name, err)
+	}
+
+	vMFA := output.VirtualMFADevice
+	d.SetId(aws.StringValue(vMFA.SerialNumber))
+
+	// The seed and QR code are only returned on creation.
+	d.Set("base_32_string_seed", string(vMFA.Base32StringSeed))
+	d.Set("qr_code_png", base64.StdEncoding.EncodeToString(vMFA.QRCodePNG))
+
+	return resourceVirtualMFADeviceRead(d, meta)
+}
+
+func resourceVirtualMFADeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	vMFA, err := FindVirtualMFADeviceBySerialNumber(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Virtual MFA Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Virtual MFA Device (%s): %w", d.Id(), err)
+	}
+
+	path, name, err := parseVirtualMFADeviceARN(aws.StringValue(vMFA.SerialNumber))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", vMFA.SerialNumber)
+	d.Set("path", path)
+	d.Set("virtual_mfa_device_name", name)
+
+	tagsOutput, err := conn.ListMFADeviceTags(&iam.ListMFADeviceTagsInput{
+		SerialNumber: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IAM Virtual MFA Device (%s): %w", d.Id(), err)
+	}
+
+	tags := KeyValueTags(tagsOutput.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVirtualMFADeviceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := virtualMFADeviceUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags for IAM Virtual MFA Device (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceVirtualMFADeviceRead(d, meta)
+}
+
+func resourceVirtualMFADeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	log.Printf("[INFO] Deleting IAM Virtual MFA Device: %s", d.Id())
+	_, err := conn.DeleteVirtualMFADevice(&iam.DeleteVirtualMFADeviceInput{
+		SerialNumber: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Virtual MFA Device (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// parseVirtualMFADeviceARN returns the path and name from a Virtual MFA Device ARN,
+// e.g. arn:aws:iam::123456789012:mfa/path/name.
+func parseVirtualMFADeviceARN(s string) (string, string, error) {
+	m := regexp.MustCompile(`^arn:[^:]+:iam::\d{12}:mfa(/(?:[^/]+/)*)([^/]+)$`).FindStringSubmatch(s)
+
+	if m == nil {
+		return "", "", fmt.Errorf("unexpected format for IAM Virtual MFA Device ARN (%s)", s)
+	}
+
+	return m[1], m[2], nil
+}
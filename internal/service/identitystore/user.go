@@ -0,0 +1,621 @@
+package identitystore
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserCreate,
+		Read:   resourceUserRead,
+		Delete: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"locality": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"postal_code": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"street_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+
+			"external_ids": externalIDsSchema(),
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentityStoreID,
+			},
+
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"name": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"family_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"given_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_suffix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"middle_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+
+			"nickname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"phone_numbers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+
+			"preferred_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"profile_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"user_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.CreateUserInput{
+		DisplayName:     aws.String(d.Get("display_name").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+		UserName:        aws.String(d.Get("user_name").(string)),
+	}
+
+	if v, ok := d.GetOk("addresses"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Addresses = []*identitystore.Address{expandAddress(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("emails"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Emails = []*identitystore.Email{expandEmail(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Name = expandName(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("nickname"); ok {
+		input.NickName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("phone_numbers"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PhoneNumbers = []*identitystore.PhoneNumber{expandPhoneNumber(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("preferred_language"); ok {
+		input.PreferredLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("profile_url"); ok {
+		input.ProfileUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		input.Timezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		input.Title = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_type"); ok {
+		input.UserType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Identity Store User: %w", err)
+	}
+
+	d.SetId(UserCreateResourceID(identityStoreID, aws.StringValue(output.UserId)))
+
+	return resourceUserRead(d, meta)
+}
+
+func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindUserByTwoPartKey(conn, identityStoreID, userID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Identity Store User (%s): %w", d.Id(), err)
+	}
+
+	if len(output.Addresses) > 0 {
+		if err := d.Set("addresses", []interface{}{flattenAddress(output.Addresses[0])}); err != nil {
+			return fmt.Errorf("error setting addresses: %w", err)
+		}
+	} else {
+		d.Set("addresses", nil)
+	}
+	d.Set("display_name", output.DisplayName)
+	if len(output.Emails) > 0 {
+		if err := d.Set("emails", []interface{}{flattenEmail(output.Emails[0])}); err != nil {
+			return fmt.Errorf("error setting emails: %w", err)
+		}
+	} else {
+		d.Set("emails", nil)
+	}
+	if err := d.Set("external_ids", flattenExternalIDs(output.ExternalIds)); err != nil {
+		return fmt.Errorf("error setting external_ids: %w", err)
+	}
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("locale", output.Locale)
+	if output.Name != nil {
+		if err := d.Set("name", []interface{}{flattenName(output.Name)}); err != nil {
+			return fmt.Errorf("error setting name: %w", err)
+		}
+	} else {
+		d.Set("name", nil)
+	}
+	d.Set("nickname", output.NickName)
+	if len(output.PhoneNumbers) > 0 {
+		if err := d.Set("phone_numbers", []interface{}{flattenPhoneNumber(output.PhoneNumbers[0])}); err != nil {
+			return fmt.Errorf("error setting phone_numbers: %w", err)
+		}
+	} else {
+		d.Set("phone_numbers", nil)
+	}
+	d.Set("preferred_language", output.PreferredLanguage)
+	d.Set("profile_url", output.ProfileUrl)
+	d.Set("timezone", output.Timezone)
+	d.Set("title", output.Title)
+	d.Set("user_id", output.UserId)
+	d.Set("user_name", output.UserName)
+	d.Set("user_type", output.UserType)
+
+	return nil
+}
+
+func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store User: %s", d.Id())
+	_, err = conn.DeleteUser(&identitystore.DeleteUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Identity Store User (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const userResourceIDSeparator = ","
+
+func UserCreateResourceID(identityStoreID, userID string) string {
+	parts := []string{identityStoreID, userID}
+	id := strings.Join(parts, userResourceIDSeparator)
+
+	return id
+}
+
+func UserParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTITY_STORE_ID%[2]sUSER_ID", id, userResourceIDSeparator)
+}
+
+func expandAddress(tfMap map[string]interface{}) *identitystore.Address {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.Address{}
+
+	if v, ok := tfMap["country"].(string); ok && v != "" {
+		apiObject.Country = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["locality"].(string); ok && v != "" {
+		apiObject.Locality = aws.String(v)
+	}
+
+	if v, ok := tfMap["postal_code"].(string); ok && v != "" {
+		apiObject.PostalCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["street_address"].(string); ok && v != "" {
+		apiObject.StreetAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAddress(apiObject *identitystore.Address) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"country":        aws.StringValue(apiObject.Country),
+		"formatted":      aws.StringValue(apiObject.Formatted),
+		"locality":       aws.StringValue(apiObject.Locality),
+		"postal_code":    aws.StringValue(apiObject.PostalCode),
+		"primary":        aws.BoolValue(apiObject.Primary),
+		"region":         aws.StringValue(apiObject.Region),
+		"street_address": aws.StringValue(apiObject.StreetAddress),
+		"type":           aws.StringValue(apiObject.Type),
+	}
+}
+
+func expandEmail(tfMap map[string]interface{}) *identitystore.Email {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.Email{}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEmail(apiObject *identitystore.Email) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"primary": aws.BoolValue(apiObject.Primary),
+		"type":    aws.StringValue(apiObject.Type),
+		"value":   aws.StringValue(apiObject.Value),
+	}
+}
+
+func expandName(tfMap map[string]interface{}) *identitystore.Name {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.Name{}
+
+	if v, ok := tfMap["family_name"].(string); ok && v != "" {
+		apiObject.FamilyName = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["given_name"].(string); ok && v != "" {
+		apiObject.GivenName = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_prefix"].(string); ok && v != "" {
+		apiObject.HonorificPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_suffix"].(string); ok && v != "" {
+		apiObject.HonorificSuffix = aws.String(v)
+	}
+
+	if v, ok := tfMap["middle_name"].(string); ok && v != "" {
+		apiObject.MiddleName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenName(apiObject *identitystore.Name) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"family_name":      aws.StringValue(apiObject.FamilyName),
+		"formatted":        aws.StringValue(apiObject.Formatted),
+		"given_name":       aws.StringValue(apiObject.GivenName),
+		"honorific_prefix": aws.StringValue(apiObject.HonorificPrefix),
+		"honorific_suffix": aws.StringValue(apiObject.HonorificSuffix),
+		"middle_name":      aws.StringValue(apiObject.MiddleName),
+	}
+}
+
+func expandPhoneNumber(tfMap map[string]interface{}) *identitystore.PhoneNumber {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.PhoneNumber{}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPhoneNumber(apiObject *identitystore.PhoneNumber) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"primary": aws.BoolValue(apiObject.Primary),
+		"type":    aws.StringValue(apiObject.Type),
+		"value":   aws.StringValue(apiObject.Value),
+	}
+}
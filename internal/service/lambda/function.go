@@ -146,6 +146,21 @@ func ResourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"ephemeral_storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(512, 10240),
+						},
+					},
+				},
+			},
 			"file_system_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -281,6 +296,25 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"snap_start": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lambda.SnapStartApplyOn_Values(), false),
+						},
+						"optimization_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+			},
 			"source_code_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -369,6 +403,7 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 func hasConfigChanges(d verify.ResourceDiffer) bool {
 	return d.HasChange("description") ||
 		d.HasChange("handler") ||
+		d.HasChange("ephemeral_storage") ||
 		d.HasChange("file_system_config") ||
 		d.HasChange("image_config") ||
 		d.HasChange("memory_size") ||
@@ -381,6 +416,7 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 		d.HasChange("vpc_config.0.security_group_ids") ||
 		d.HasChange("vpc_config.0.subnet_ids") ||
 		d.HasChange("runtime") ||
+		d.HasChange("snap_start") ||
 		d.HasChange("environment")
 }
 
@@ -481,6 +517,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("ephemeral_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.EphemeralStorage = expandEphemeralStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("file_system_config"); ok && len(v.([]interface{})) > 0 {
 		params.FileSystemConfigs = expandFileSystemConfigs(v.([]interface{}))
 	}
@@ -526,6 +566,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.SnapStart = expandSnapStart(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if len(tags) > 0 {
 		params.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -751,6 +795,14 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting signing job arn for Lambda Function: %w", err)
 	}
 
+	if err := d.Set("ephemeral_storage", flattenEphemeralStorage(function.EphemeralStorage)); err != nil {
+		return fmt.Errorf("error setting ephemeral_storage for Lambda Function (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
+		return fmt.Errorf("error setting snap_start for Lambda Function (%s): %w", d.Id(), err)
+	}
+
 	fileSystemConfigs := flattenFileSystemConfigs(function.FileSystemConfigs)
 	log.Printf("[INFO] Setting Lambda %s file system configs %#v from API", d.Id(), fileSystemConfigs)
 	if err := d.Set("file_system_config", fileSystemConfigs); err != nil {
@@ -987,6 +1039,11 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("handler") {
 		configReq.Handler = aws.String(d.Get("handler").(string))
 	}
+	if d.HasChange("ephemeral_storage") {
+		if v, ok := d.GetOk("ephemeral_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			configReq.EphemeralStorage = expandEphemeralStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
 	if d.HasChange("file_system_config") {
 		configReq.FileSystemConfigs = make([]*lambda.FileSystemConfig, 0)
 		if v, ok := d.GetOk("file_system_config"); ok && len(v.([]interface{})) > 0 {
@@ -1049,6 +1106,14 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("runtime") {
 		configReq.Runtime = aws.String(d.Get("runtime").(string))
 	}
+	if d.HasChange("snap_start") {
+		configReq.SnapStart = &lambda.SnapStart{
+			ApplyOn: aws.String(lambda.SnapStartApplyOnNone),
+		}
+		if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			configReq.SnapStart = expandSnapStart(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
 	if d.HasChange("environment") {
 		if v, ok := d.GetOk("environment"); ok {
 			environments := v.([]interface{})
@@ -1444,3 +1509,57 @@ func expandImageConfigs(imageConfigMaps []interface{}) *lambda.ImageConfig {
 	}
 	return imageConfig
 }
+
+func expandEphemeralStorage(tfMap map[string]interface{}) *lambda.EphemeralStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.EphemeralStorage{}
+
+	if v, ok := tfMap["size"].(int); ok && v != 0 {
+		apiObject.Size = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenEphemeralStorage(apiObject *lambda.EphemeralStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"size": aws.Int64Value(apiObject.Size),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSnapStart(tfMap map[string]interface{}) *lambda.SnapStart {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.SnapStart{}
+
+	if v, ok := tfMap["apply_on"].(string); ok && v != "" {
+		apiObject.ApplyOn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSnapStart(apiObject *lambda.SnapStartResponse) []interface{} {
+	// SnapStart is reported as "None" when it is not enabled.
+	if apiObject == nil || aws.StringValue(apiObject.ApplyOn) == lambda.SnapStartApplyOnNone {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"apply_on":            aws.StringValue(apiObject.ApplyOn),
+		"optimization_status": aws.StringValue(apiObject.OptimizationStatus),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,433 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	functionURLResourceIDSeparator = "/"
+
+	// Statement ID of the resource-based policy statement that allows public access to a function URL.
+	functionURLPublicAccessStatementID = "FunctionURLAllowPublicAccess"
+)
+
+func ResourceFunctionURL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFunctionURLCreate,
+		ReadContext:   resourceFunctionURLRead,
+		UpdateContext: resourceFunctionURLUpdate,
+		DeleteContext: resourceFunctionURLDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authorization_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lambda.FunctionUrlAuthType_Values(), false),
+			},
+			"cors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"allow_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_methods": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_origins": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtMost(86400),
+						},
+					},
+				},
+			},
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invoke_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.InvokeModeBuffered,
+				ValidateFunc: validation.StringInSlice(lambda.InvokeMode_Values(), false),
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"url_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := FunctionURLCreateResourceID(name, qualifier)
+	authorizationType := d.Get("authorization_type").(string)
+	input := &lambda.CreateFunctionUrlConfigInput{
+		AuthType:     aws.String(authorizationType),
+		FunctionName: aws.String(name),
+		InvokeMode:   aws.String(d.Get("invoke_mode").(string)),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lambda Function URL: %s", input)
+	_, err := conn.CreateFunctionUrlConfigWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lambda Function URL (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if authorizationType == lambda.FunctionUrlAuthTypeNone {
+		if err := addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+			return diag.Errorf("error adding Lambda Function URL (%s) public access permission: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFunctionURLRead(ctx, d, meta)
+}
+
+func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindFunctionURLConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function URL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lambda Function URL (%s): %s", d.Id(), err)
+	}
+
+	functionURL := aws.StringValue(output.FunctionUrl)
+
+	d.Set("authorization_type", output.AuthType)
+	if output.Cors != nil {
+		if err := d.Set("cors", []interface{}{flattenFunctionURLCors(output.Cors)}); err != nil {
+			return diag.Errorf("error setting cors: %s", err)
+		}
+	} else {
+		d.Set("cors", nil)
+	}
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", name)
+	d.Set("function_url", functionURL)
+	d.Set("invoke_mode", output.InvokeMode)
+	d.Set("qualifier", qualifier)
+
+	// Function URL endpoints have the following format:
+	// https://<url-id>.lambda-url.<region>.on.aws
+	v, err := url.Parse(functionURL)
+
+	if err != nil {
+		return diag.Errorf("error parsing Lambda Function URL (%s): %s", functionURL, err)
+	}
+
+	d.Set("url_id", strings.Split(v.Host, ".")[0])
+
+	return nil
+}
+
+func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &lambda.UpdateFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if d.HasChange("authorization_type") {
+		input.AuthType = aws.String(d.Get("authorization_type").(string))
+	}
+
+	if d.HasChange("cors") {
+		if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Cors = &lambda.Cors{}
+		}
+	}
+
+	if d.HasChange("invoke_mode") {
+		input.InvokeMode = aws.String(d.Get("invoke_mode").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Lambda Function URL: %s", input)
+	_, err = conn.UpdateFunctionUrlConfigWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Lambda Function URL (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("authorization_type") {
+		if d.Get("authorization_type").(string) == lambda.FunctionUrlAuthTypeNone {
+			err = addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier)
+		} else {
+			err = removeFunctionURLPublicAccessPermission(ctx, conn, name, qualifier)
+		}
+
+		if err != nil {
+			return diag.Errorf("error updating Lambda Function URL (%s) public access permission: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFunctionURLRead(ctx, d, meta)
+}
+
+func resourceFunctionURLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &lambda.DeleteFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[INFO] Deleting Lambda Function URL: %s", d.Id())
+	_, err = conn.DeleteFunctionUrlConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lambda Function URL (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("authorization_type").(string) == lambda.FunctionUrlAuthTypeNone {
+		if err := removeFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+			return diag.Errorf("error removing Lambda Function URL (%s) public access permission: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func FunctionURLCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, functionURLResourceIDSeparator)
+
+	return id
+}
+
+func FunctionURLParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, functionURLResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME or FUNCTION-NAME%[2]sQUALIFIER", id, functionURLResourceIDSeparator)
+}
+
+// addFunctionURLPublicAccessPermission grants everyone permission to invoke the function URL.
+// Function URLs with an authorization type of NONE require this resource-based policy statement.
+func addFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Lambda, name, qualifier string) error {
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(name),
+		FunctionUrlAuthType: aws.String(lambda.FunctionUrlAuthTypeNone),
+		Principal:           aws.String("*"),
+		StatementId:         aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Adding Lambda Permission: %s", input)
+	_, err := conn.AddPermissionWithContext(ctx, input)
+
+	// The statement already exists, e.g. it was added outside of Terraform.
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceConflictException) {
+		return nil
+	}
+
+	return err
+}
+
+func removeFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Lambda, name, qualifier string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(name),
+		StatementId:  aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Removing Lambda Permission: %s", input)
+	_, err := conn.RemovePermissionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func expandFunctionURLCors(tfMap map[string]interface{}) *lambda.Cors {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.Cors{}
+
+	if v, ok := tfMap["allow_credentials"].(bool); ok {
+		apiObject.AllowCredentials = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_methods"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowMethods = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_origins"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowOrigins = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["expose_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExposeHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["max_age"].(int); ok && v != 0 {
+		apiObject.MaxAge = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFunctionURLCors(apiObject *lambda.Cors) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllowCredentials; v != nil {
+		tfMap["allow_credentials"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.AllowHeaders; v != nil {
+		tfMap["allow_headers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AllowMethods; v != nil {
+		tfMap["allow_methods"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AllowOrigins; v != nil {
+		tfMap["allow_origins"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ExposeHeaders; v != nil {
+		tfMap["expose_headers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.MaxAge; v != nil {
+		tfMap["max_age"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,202 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const runtimeManagementConfigResourceIDSeparator = ","
+
+func ResourceRuntimeManagementConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuntimeManagementConfigCreate,
+		ReadContext:   resourceRuntimeManagementConfigRead,
+		UpdateContext: resourceRuntimeManagementConfigUpdate,
+		DeleteContext: resourceRuntimeManagementConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"runtime_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"update_runtime_on": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.UpdateRuntimeOnAuto,
+				ValidateFunc: validation.StringInSlice(lambda.UpdateRuntimeOn_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRuntimeManagementConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := RuntimeManagementConfigCreateResourceID(name, qualifier)
+
+	if err := putRuntimeManagementConfig(ctx, conn, d, name, qualifier); err != nil {
+		return diag.Errorf("error creating Lambda Runtime Management Config (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRuntimeManagementConfigRead(ctx, d, meta)
+}
+
+func resourceRuntimeManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Runtime Management Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", name)
+	d.Set("qualifier", qualifier)
+	d.Set("runtime_version_arn", output.RuntimeVersionArn)
+	d.Set("update_runtime_on", output.UpdateRuntimeOn)
+
+	return nil
+}
+
+func resourceRuntimeManagementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := putRuntimeManagementConfig(ctx, conn, d, name, qualifier); err != nil {
+		return diag.Errorf("error updating Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return resourceRuntimeManagementConfigRead(ctx, d, meta)
+}
+
+func resourceRuntimeManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// There is no API to remove a runtime management configuration.
+	// Reset the function to the default behavior of automatic runtime updates.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(name),
+		UpdateRuntimeOn: aws.String(lambda.UpdateRuntimeOnAuto),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[INFO] Deleting Lambda Runtime Management Config: %s", d.Id())
+	_, err = conn.PutRuntimeManagementConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putRuntimeManagementConfig(ctx context.Context, conn *lambda.Lambda, d *schema.ResourceData, name, qualifier string) error {
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(name),
+		UpdateRuntimeOn: aws.String(d.Get("update_runtime_on").(string)),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("runtime_version_arn"); ok {
+		input.RuntimeVersionArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting Lambda Runtime Management Config: %s", input)
+	_, err := conn.PutRuntimeManagementConfigWithContext(ctx, input)
+
+	return err
+}
+
+func RuntimeManagementConfigCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, runtimeManagementConfigResourceIDSeparator)
+
+	return id
+}
+
+func RuntimeManagementConfigParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, runtimeManagementConfigResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME or FUNCTION-NAME%[2]sQUALIFIER", id, runtimeManagementConfigResourceIDSeparator)
+}
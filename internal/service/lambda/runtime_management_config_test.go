@@ -0,0 +1,206 @@
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLambdaRuntimeManagementConfig_basic(t *testing.T) {
+	var conf lambda.GetRuntimeManagementConfigOutput
+	resourceName := "aws_lambda_runtime_management_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRuntimeManagementConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig(rName, lambda.UpdateRuntimeOnFunctionUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", lambda.UpdateRuntimeOnFunctionUpdate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuntimeManagementConfigConfig(rName, lambda.UpdateRuntimeOnAuto),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", lambda.UpdateRuntimeOnAuto),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_qualifier(t *testing.T) {
+	var conf lambda.GetRuntimeManagementConfigOutput
+	resourceName := "aws_lambda_runtime_management_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRuntimeManagementConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigQualifierConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", "aws_lambda_function.test", "version"),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", lambda.UpdateRuntimeOnFunctionUpdate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeManagementConfigExists(n string, v *lambda.GetRuntimeManagementConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Runtime Management Config ID is set")
+		}
+
+		name, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+		output, err := tflambda.FindRuntimeManagementConfigByNameAndQualifier(context.Background(), conn, name, qualifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// The runtime management configuration is reset rather than deleted, so it
+// only disappears along with the function itself.
+func testAccCheckRuntimeManagementConfigDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_runtime_management_config" {
+			continue
+		}
+
+		name, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflambda.FindRuntimeManagementConfigByNameAndQualifier(context.Background(), conn, name, qualifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Runtime Management Config %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRuntimeManagementConfigBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"
+  role       = aws_iam_role.test.id
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  publish       = true
+  runtime       = "nodejs18.x"
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
+
+func testAccRuntimeManagementConfigConfig(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigBaseConfig(rName), fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = %[1]q
+}
+`, updateRuntimeOn))
+}
+
+func testAccRuntimeManagementConfigQualifierConfig(rName string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigBaseConfig(rName), `
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  qualifier         = aws_lambda_function.test.version
+  update_runtime_on = "FunctionUpdate"
+}
+`)
+}
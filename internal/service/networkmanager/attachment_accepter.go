@@ -0,0 +1,185 @@
+package networkmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAttachmentAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAttachmentAccepterCreate,
+		ReadContext:   resourceAttachmentAccepterRead,
+		DeleteContext: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"attachment_policy_rule_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"attachment_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					networkmanager.AttachmentTypeConnect,
+					networkmanager.AttachmentTypeSiteToSiteVpn,
+					networkmanager.AttachmentTypeVpc,
+				}, false),
+			},
+			"core_network_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"edge_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	attachmentID := d.Get("attachment_id").(string)
+	attachmentType := d.Get("attachment_type").(string)
+
+	attachment, err := findAttachmentByIDAndType(ctx, conn, attachmentID, attachmentType)
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager %s Attachment (%s): %s", attachmentType, attachmentID, err)
+	}
+
+	if state := aws.StringValue(attachment.State); state == networkmanager.AttachmentStatePendingAttachmentAcceptance || state == networkmanager.AttachmentStatePendingTagAcceptance {
+		log.Printf("[DEBUG] Accepting Network Manager Attachment: %s", attachmentID)
+		_, err := conn.AcceptAttachmentWithContext(ctx, &networkmanager.AcceptAttachmentInput{
+			AttachmentId: aws.String(attachmentID),
+		})
+
+		if err != nil {
+			return diag.Errorf("error accepting Network Manager Attachment (%s): %s", attachmentID, err)
+		}
+
+		switch attachmentType {
+		case networkmanager.AttachmentTypeConnect:
+			_, err = WaitConnectAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate))
+		case networkmanager.AttachmentTypeSiteToSiteVpn:
+			_, err = WaitSiteToSiteVPNAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate))
+		case networkmanager.AttachmentTypeVpc:
+			_, err = WaitVPCAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate))
+		}
+
+		if err != nil {
+			return diag.Errorf("error waiting for Network Manager Attachment (%s) accept: %s", attachmentID, err)
+		}
+	}
+
+	d.SetId(attachmentID)
+
+	return resourceAttachmentAccepterRead(ctx, d, meta)
+}
+
+func resourceAttachmentAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	attachmentType := d.Get("attachment_type").(string)
+	attachment, err := findAttachmentByIDAndType(ctx, conn, d.Id(), attachmentType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager %s Attachment (%s) not found, removing from state", attachmentType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager %s Attachment (%s): %s", attachmentType, d.Id(), err)
+	}
+
+	d.Set("attachment_id", attachment.AttachmentId)
+	d.Set("attachment_policy_rule_number", attachment.AttachmentPolicyRuleNumber)
+	d.Set("attachment_type", attachment.AttachmentType)
+	d.Set("core_network_arn", attachment.CoreNetworkArn)
+	d.Set("core_network_id", attachment.CoreNetworkId)
+	d.Set("edge_location", attachment.EdgeLocation)
+	d.Set("owner_account_id", attachment.OwnerAccountId)
+	d.Set("resource_arn", attachment.ResourceArn)
+	d.Set("segment_name", attachment.SegmentName)
+	d.Set("state", attachment.State)
+
+	return nil
+}
+
+// findAttachmentByIDAndType returns the common attachment details for the
+// typed attachment with the specified ID.
+func findAttachmentByIDAndType(ctx context.Context, conn *networkmanager.NetworkManager, id, attachmentType string) (*networkmanager.Attachment, error) {
+	switch attachmentType {
+	case networkmanager.AttachmentTypeConnect:
+		output, err := FindConnectAttachmentByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.Attachment, nil
+
+	case networkmanager.AttachmentTypeSiteToSiteVpn:
+		output, err := FindSiteToSiteVPNAttachmentByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.Attachment, nil
+
+	case networkmanager.AttachmentTypeVpc:
+		output, err := FindVPCAttachmentByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.Attachment, nil
+	}
+
+	return nil, fmt.Errorf("unsupported Network Manager Attachment type: %s", attachmentType)
+}
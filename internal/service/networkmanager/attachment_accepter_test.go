@@ -0,0 +1,55 @@
+package networkmanager_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerAttachmentAccepter_vpcAttachment(t *testing.T) {
+	var v networkmanager.VpcAttachment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkmanager_attachment_accepter.test"
+	vpcAttachmentResourceName := "aws_networkmanager_vpc_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVPCAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttachmentAccepterVPCAttachmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCAttachmentExists(vpcAttachmentResourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "attachment_id", vpcAttachmentResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "attachment_type", networkmanager.AttachmentTypeVpc),
+					resource.TestCheckResourceAttrPair(resourceName, "core_network_arn", vpcAttachmentResourceName, "core_network_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "core_network_id", vpcAttachmentResourceName, "core_network_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "edge_location", vpcAttachmentResourceName, "edge_location"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", vpcAttachmentResourceName, "vpc_arn"),
+					resource.TestCheckResourceAttr(resourceName, "segment_name", "shared"),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.AttachmentStateAvailable),
+				),
+			},
+		},
+	})
+}
+
+func testAccAttachmentAccepterVPCAttachmentConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVPCAttachmentBaseConfig(rName, 1, true), `
+resource "aws_networkmanager_vpc_attachment" "test" {
+  subnet_arns     = aws_subnet.test[*].arn
+  core_network_id = aws_networkmanager_core_network_policy_attachment.test.core_network_id
+  vpc_arn         = aws_vpc.test.arn
+}
+
+resource "aws_networkmanager_attachment_accepter" "test" {
+  attachment_id   = aws_networkmanager_vpc_attachment.test.id
+  attachment_type = aws_networkmanager_vpc_attachment.test.attachment_type
+}
+`)
+}
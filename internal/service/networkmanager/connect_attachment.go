@@ -0,0 +1,276 @@
+package networkmanager
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConnectAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConnectAttachmentCreate,
+		ReadContext:   resourceConnectAttachmentRead,
+		UpdateContext: resourceConnectAttachmentUpdate,
+		DeleteContext: resourceConnectAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attachment_policy_rule_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"attachment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_network_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"edge_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      networkmanager.TunnelProtocolGre,
+							ValidateFunc: validation.StringInSlice(networkmanager.TunnelProtocol_Values(), false),
+						},
+					},
+				},
+			},
+			"owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"transport_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConnectAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	coreNetworkID := d.Get("core_network_id").(string)
+	transportAttachmentID := d.Get("transport_attachment_id").(string)
+	input := &networkmanager.CreateConnectAttachmentInput{
+		ClientToken:           aws.String(resource.UniqueId()),
+		CoreNetworkId:         aws.String(coreNetworkID),
+		EdgeLocation:          aws.String(d.Get("edge_location").(string)),
+		TransportAttachmentId: aws.String(transportAttachmentID),
+	}
+
+	if v, ok := d.GetOk("options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Options = expandConnectAttachmentOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Connect Attachment: %s", input)
+	output, err := conn.CreateConnectAttachmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Connect (%s) Attachment (%s): %s", transportAttachmentID, coreNetworkID, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectAttachment.Attachment.AttachmentId))
+
+	if _, err := WaitConnectAttachmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Connect Attachment (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceConnectAttachmentRead(ctx, d, meta)
+}
+
+func resourceConnectAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	connectAttachment, err := FindConnectAttachmentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Connect Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Connect Attachment (%s): %s", d.Id(), err)
+	}
+
+	a := connectAttachment.Attachment
+	d.Set("arn", attachmentARN(meta.(*conns.AWSClient).Partition, aws.StringValue(a.OwnerAccountId), d.Id()))
+	d.Set("attachment_policy_rule_number", a.AttachmentPolicyRuleNumber)
+	d.Set("attachment_type", a.AttachmentType)
+	d.Set("core_network_arn", a.CoreNetworkArn)
+	d.Set("core_network_id", a.CoreNetworkId)
+	d.Set("edge_location", a.EdgeLocation)
+	if connectAttachment.Options != nil {
+		if err := d.Set("options", []interface{}{flattenConnectAttachmentOptions(connectAttachment.Options)}); err != nil {
+			return diag.Errorf("error setting options: %s", err)
+		}
+	} else {
+		d.Set("options", nil)
+	}
+	d.Set("owner_account_id", a.OwnerAccountId)
+	d.Set("resource_arn", a.ResourceArn)
+	d.Set("segment_name", a.SegmentName)
+	d.Set("state", a.State)
+	d.Set("transport_attachment_id", connectAttachment.TransportAttachmentId)
+
+	tags := KeyValueTags(a.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConnectAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Network Manager Connect Attachment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConnectAttachmentRead(ctx, d, meta)
+}
+
+func resourceConnectAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	// An attachment that has not been accepted must be rejected before it can be deleted.
+	if state := d.Get("state").(string); state == networkmanager.AttachmentStatePendingAttachmentAcceptance || state == networkmanager.AttachmentStatePendingTagAcceptance {
+		if err := rejectAttachment(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := WaitConnectAttachmentRejected(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error waiting for Network Manager Connect Attachment (%s) reject: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Connect Attachment: %s", d.Id())
+	_, err := conn.DeleteAttachmentWithContext(ctx, &networkmanager.DeleteAttachmentInput{
+		AttachmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Connect Attachment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitConnectAttachmentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Connect Attachment (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConnectAttachmentOptions(tfMap map[string]interface{}) *networkmanager.ConnectAttachmentOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.ConnectAttachmentOptions{}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenConnectAttachmentOptions(apiObject *networkmanager.ConnectAttachmentOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Protocol; v != nil {
+		tfMap["protocol"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
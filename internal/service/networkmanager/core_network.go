@@ -0,0 +1,385 @@
+package networkmanager
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCoreNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCoreNetworkCreate,
+		ReadContext:   resourceCoreNetworkRead,
+		UpdateContext: resourceCoreNetworkUpdate,
+		DeleteContext: resourceCoreNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_policy_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"create_base_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"edges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"edge_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inside_cidr_blocks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"global_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"segments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shared_segments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCoreNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.CreateCoreNetworkInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if d.Get("create_base_policy").(bool) {
+		region := meta.(*conns.AWSClient).Region
+
+		if v, ok := d.GetOk("base_policy_region"); ok {
+			region = v.(string)
+		}
+
+		policyDocument, err := coreNetworkBasePolicyDocument(region)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.PolicyDocument = aws.String(policyDocument)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Core Network: %s", input)
+	output, err := conn.CreateCoreNetworkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Core Network (%s): %s", globalNetworkID, err)
+	}
+
+	d.SetId(aws.StringValue(output.CoreNetwork.CoreNetworkId))
+
+	if _, err := WaitCoreNetworkCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Core Network (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceCoreNetworkRead(ctx, d, meta)
+}
+
+func resourceCoreNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	coreNetwork, err := FindCoreNetworkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Core Network (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Core Network (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", coreNetwork.CoreNetworkArn)
+	if coreNetwork.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(coreNetwork.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("description", coreNetwork.Description)
+	if err := d.Set("edges", flattenCoreNetworkEdges(coreNetwork.Edges)); err != nil {
+		return diag.Errorf("error setting edges: %s", err)
+	}
+	d.Set("global_network_id", coreNetwork.GlobalNetworkId)
+	if err := d.Set("segments", flattenCoreNetworkSegments(coreNetwork.Segments)); err != nil {
+		return diag.Errorf("error setting segments: %s", err)
+	}
+	d.Set("state", coreNetwork.State)
+
+	tags := KeyValueTags(coreNetwork.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCoreNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChange("description") {
+		input := &networkmanager.UpdateCoreNetworkInput{
+			CoreNetworkId: aws.String(d.Id()),
+			Description:   aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Core Network: %s", input)
+		_, err := conn.UpdateCoreNetworkWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Network Manager Core Network (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Network Manager Core Network (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCoreNetworkRead(ctx, d, meta)
+}
+
+func resourceCoreNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	log.Printf("[DEBUG] Deleting Network Manager Core Network: %s", d.Id())
+	_, err := conn.DeleteCoreNetworkWithContext(ctx, &networkmanager.DeleteCoreNetworkInput{
+		CoreNetworkId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Core Network (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitCoreNetworkDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Core Network (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// coreNetworkBasePolicyDocument returns a minimal policy document with a single edge location and segment.
+// A core network must have a LIVE policy before attachments can be created, so callers that manage their
+// policy separately (e.g. via aws_networkmanager_core_network_policy_attachment) can start from this base.
+func coreNetworkBasePolicyDocument(region string) (string, error) {
+	policyDocument := map[string]interface{}{
+		"version": "2021.12",
+		"core-network-configuration": map[string]interface{}{
+			"asn-ranges": []string{"64512-65534"},
+			"edge-locations": []interface{}{
+				map[string]interface{}{
+					"location": region,
+				},
+			},
+		},
+		"segments": []interface{}{
+			map[string]interface{}{
+				"name":        "segment",
+				"description": "base-policy",
+			},
+		},
+	}
+
+	b, err := json.Marshal(policyDocument)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenCoreNetworkEdge(apiObject *networkmanager.CoreNetworkEdge) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Asn; v != nil {
+		tfMap["asn"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.EdgeLocation; v != nil {
+		tfMap["edge_location"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InsideCidrBlocks; v != nil {
+		tfMap["inside_cidr_blocks"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCoreNetworkEdges(apiObjects []*networkmanager.CoreNetworkEdge) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCoreNetworkEdge(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCoreNetworkSegment(apiObject *networkmanager.CoreNetworkSegment) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EdgeLocations; v != nil {
+		tfMap["edge_locations"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SharedSegments; v != nil {
+		tfMap["shared_segments"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCoreNetworkSegments(apiObjects []*networkmanager.CoreNetworkSegment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCoreNetworkSegment(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,197 @@
+package networkmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCoreNetworkPolicyAttachmentCreate,
+		ReadContext:   resourceCoreNetworkPolicyAttachmentRead,
+		UpdateContext: resourceCoreNetworkPolicyAttachmentUpdate,
+		DeleteContext: resourceCoreNetworkPolicyAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"core_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCoreNetworkPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	coreNetworkID := d.Get("core_network_id").(string)
+
+	if err := putAndExecuteCoreNetworkPolicy(ctx, conn, coreNetworkID, d.Get("policy_document").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(coreNetworkID)
+
+	return resourceCoreNetworkPolicyAttachmentRead(ctx, d, meta)
+}
+
+func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	coreNetwork, err := FindCoreNetworkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Core Network (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Core Network (%s): %s", d.Id(), err)
+	}
+
+	// The LIVE policy version is the one currently in effect on the core network.
+	policy, err := FindCoreNetworkPolicyByAlias(ctx, conn, d.Id(), networkmanager.CoreNetworkPolicyAliasLive)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Core Network (%s) LIVE policy not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Core Network (%s) LIVE policy: %s", d.Id(), err)
+	}
+
+	d.Set("core_network_id", coreNetwork.CoreNetworkId)
+
+	if policy.PolicyDocument != nil {
+		b, err := json.Marshal(policy.PolicyDocument)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		policyDocument, err := structure.NormalizeJsonString(string(b))
+
+		if err != nil {
+			return diag.Errorf("policy (%s) contains invalid JSON: %s", string(b), err)
+		}
+
+		d.Set("policy_document", policyDocument)
+	} else {
+		d.Set("policy_document", nil)
+	}
+
+	d.Set("state", coreNetwork.State)
+
+	return nil
+}
+
+func resourceCoreNetworkPolicyAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChange("policy_document") {
+		if err := putAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCoreNetworkPolicyAttachmentRead(ctx, d, meta)
+}
+
+func resourceCoreNetworkPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A core network always has a policy once one has been set; the policy versions are removed when the core network is deleted.
+	log.Printf("[WARN] Network Manager Core Network (%s) policy cannot be detached, removing from state only", d.Id())
+
+	return nil
+}
+
+// putAndExecuteCoreNetworkPolicy creates a new version of a core network's policy and makes it LIVE.
+func putAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, policyDocument string, timeout time.Duration) error {
+	var document aws.JSONValue
+
+	if err := json.Unmarshal([]byte(policyDocument), &document); err != nil {
+		return fmt.Errorf("error decoding Network Manager Core Network (%s) policy document: %w", coreNetworkID, err)
+	}
+
+	input := &networkmanager.PutCoreNetworkPolicyInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		CoreNetworkId:  aws.String(coreNetworkID),
+		PolicyDocument: document,
+	}
+
+	log.Printf("[DEBUG] Putting Network Manager Core Network policy: %s", input)
+	output, err := conn.PutCoreNetworkPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Network Manager Core Network (%s) policy: %w", coreNetworkID, err)
+	}
+
+	policyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
+
+	policy, err := WaitCoreNetworkPolicyChangeSetReadyToExecute(ctx, conn, coreNetworkID, policyVersionID, timeout)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Network Manager Core Network (%s) policy version (%d) change set: %w", coreNetworkID, policyVersionID, err)
+	}
+
+	// Nothing to execute if the new version does not change the core network.
+	if aws.StringValue(policy.ChangeSetState) == networkmanager.ChangeSetStateExecutionSucceeded {
+		return nil
+	}
+
+	_, err = conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Network Manager Core Network (%s) policy version (%d) change set: %w", coreNetworkID, policyVersionID, err)
+	}
+
+	if _, err := WaitCoreNetworkPolicyChangeSetExecuted(ctx, conn, coreNetworkID, policyVersionID, timeout); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Core Network (%s) policy version (%d) change set execution: %w", coreNetworkID, policyVersionID, err)
+	}
+
+	return nil
+}
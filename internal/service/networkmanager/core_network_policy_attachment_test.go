@@ -0,0 +1,117 @@
+package networkmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmanager "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+)
+
+func TestAccNetworkManagerCoreNetworkPolicyAttachment_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCoreNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig("segment1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "core_network_id", "aws_networkmanager_core_network.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "policy_document", regexp.MustCompile(`"name":"segment1"`)),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig("segment2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy_document", regexp.MustCompile(`"name":"segment2"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCoreNetworkPolicyAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Core Network ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+		_, err := tfnetworkmanager.FindCoreNetworkPolicyByAlias(context.Background(), conn, rs.Primary.ID, networkmanager.CoreNetworkPolicyAliasLive)
+
+		return err
+	}
+}
+
+func testAccCoreNetworkPolicyAttachmentConfig(segmentName string) string {
+	return testAccCoreNetworkPolicyAttachmentBaseConfig(segmentName, false)
+}
+
+func testAccCoreNetworkPolicyAttachmentBaseConfig(segmentName string, requireAcceptance bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_core_network" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_core_network_policy_attachment" "test" {
+  core_network_id = aws_networkmanager_core_network.test.id
+
+  policy_document = jsonencode({
+    "version" : "2021.12",
+    "core-network-configuration" : {
+      "asn-ranges" : ["65022-65534"],
+      "edge-locations" : [
+        { "location" : data.aws_region.current.name }
+      ]
+    },
+    "segments" : [
+      {
+        "name" : %[1]q,
+        "require-attachment-acceptance" : %[2]t
+      }
+    ],
+    "attachment-policies" : [
+      {
+        "rule-number" : 1,
+        "condition-logic" : "or",
+        "conditions" : [
+          { "type" : "any" }
+        ],
+        "action" : {
+          "association-method" : "constant",
+          "segment" : %[1]q
+        }
+      }
+    ]
+  })
+}
+`, segmentName, requireAcceptance)
+}
@@ -0,0 +1,48 @@
+package networkmanager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+func AttachmentError(apiObject *networkmanager.AttachmentError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Code), aws.StringValue(apiObject.Message), nil)
+}
+
+func AttachmentsError(apiObjects []*networkmanager.AttachmentError) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := AttachmentError(apiObject); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func CoreNetworkPolicyError(apiObject *networkmanager.CoreNetworkPolicyError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.Message), nil)
+}
+
+func CoreNetworkPolicyErrors(apiObjects []*networkmanager.CoreNetworkPolicyError) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CoreNetworkPolicyError(apiObject); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
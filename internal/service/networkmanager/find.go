@@ -0,0 +1,221 @@
+package networkmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConnectAttachmentByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.ConnectAttachment, error) {
+	input := &networkmanager.GetConnectAttachmentInput{
+		AttachmentId: aws.String(id),
+	}
+
+	output, err := conn.GetConnectAttachmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConnectAttachment == nil || output.ConnectAttachment.Attachment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConnectAttachment, nil
+}
+
+func FindCoreNetworkByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.CoreNetwork, error) {
+	input := &networkmanager.GetCoreNetworkInput{
+		CoreNetworkId: aws.String(id),
+	}
+
+	output, err := conn.GetCoreNetworkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetwork == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetwork, nil
+}
+
+// FindCoreNetworkPolicyByAlias returns the core network policy version with the specified alias (LIVE or LATEST).
+func FindCoreNetworkPolicyByAlias(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, alias string) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		Alias:         aws.String(alias),
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func findCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCoreNetworkPolicyInput) (*networkmanager.CoreNetworkPolicy, error) {
+	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetworkPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetworkPolicy, nil
+}
+
+func FindGlobalNetwork(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.DescribeGlobalNetworksInput) (*networkmanager.GlobalNetwork, error) {
+	output, err := FindGlobalNetworks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindGlobalNetworks(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.DescribeGlobalNetworksInput) ([]*networkmanager.GlobalNetwork, error) {
+	var output []*networkmanager.GlobalNetwork
+
+	err := conn.DescribeGlobalNetworksPagesWithContext(ctx, input, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GlobalNetworks {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindGlobalNetworkByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindGlobalNetwork(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.GlobalNetworkId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindSiteToSiteVPNAttachmentByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.SiteToSiteVpnAttachment, error) {
+	input := &networkmanager.GetSiteToSiteVpnAttachmentInput{
+		AttachmentId: aws.String(id),
+	}
+
+	output, err := conn.GetSiteToSiteVpnAttachmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SiteToSiteVpnAttachment == nil || output.SiteToSiteVpnAttachment.Attachment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SiteToSiteVpnAttachment, nil
+}
+
+func FindVPCAttachmentByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.VpcAttachment, error) {
+	input := &networkmanager.GetVpcAttachmentInput{
+		AttachmentId: aws.String(id),
+	}
+
+	output, err := conn.GetVpcAttachmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VpcAttachment == nil || output.VpcAttachment.Attachment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VpcAttachment, nil
+}
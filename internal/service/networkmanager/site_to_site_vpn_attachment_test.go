@@ -0,0 +1,111 @@
+package networkmanager_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmanager "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The VPN connection must not be attached to a transit gateway or virtual private
+// gateway, so an existing VPN connection must be supplied via the
+// NETWORKMANAGER_VPN_CONNECTION_ARN environment variable.
+func testAccSiteToSiteVPNAttachmentPreCheck(t *testing.T) string {
+	vpnConnectionARN := os.Getenv("NETWORKMANAGER_VPN_CONNECTION_ARN")
+
+	if vpnConnectionARN == "" {
+		t.Skip("Environment variable NETWORKMANAGER_VPN_CONNECTION_ARN is not set")
+	}
+
+	return vpnConnectionARN
+}
+
+func TestAccNetworkManagerSiteToSiteVPNAttachment_basic(t *testing.T) {
+	vpnConnectionARN := testAccSiteToSiteVPNAttachmentPreCheck(t)
+	resourceName := "aws_networkmanager_site_to_site_vpn_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSiteToSiteVPNAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteToSiteVPNAttachmentConfig(vpnConnectionARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSiteToSiteVPNAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attachment_type", networkmanager.AttachmentTypeSiteToSiteVpn),
+					resource.TestCheckResourceAttrPair(resourceName, "core_network_id", "aws_networkmanager_core_network.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "segment_name", "shared"),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.AttachmentStateAvailable),
+					resource.TestCheckResourceAttr(resourceName, "vpn_connection_arn", vpnConnectionARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSiteToSiteVPNAttachmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_site_to_site_vpn_attachment" {
+			continue
+		}
+
+		_, err := tfnetworkmanager.FindSiteToSiteVPNAttachmentByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Site To Site VPN Attachment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSiteToSiteVPNAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Site To Site VPN Attachment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+		_, err := tfnetworkmanager.FindSiteToSiteVPNAttachmentByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSiteToSiteVPNAttachmentConfig(vpnConnectionARN string) string {
+	return acctest.ConfigCompose(testAccCoreNetworkPolicyAttachmentBaseConfig("shared", false), fmt.Sprintf(`
+resource "aws_networkmanager_site_to_site_vpn_attachment" "test" {
+  core_network_id    = aws_networkmanager_core_network_policy_attachment.test.core_network_id
+  vpn_connection_arn = %[1]q
+}
+`, vpnConnectionARN))
+}
@@ -0,0 +1,356 @@
+package networkmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVPCAttachmentCreate,
+		ReadContext:   resourceVPCAttachmentRead,
+		UpdateContext: resourceVPCAttachmentUpdate,
+		DeleteContext: resourceVPCAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attachment_policy_rule_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"attachment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_network_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+			"edge_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"appliance_mode_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"ipv6_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVPCAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	coreNetworkID := d.Get("core_network_id").(string)
+	vpcARN := d.Get("vpc_arn").(string)
+	input := &networkmanager.CreateVpcAttachmentInput{
+		ClientToken:   aws.String(resource.UniqueId()),
+		CoreNetworkId: aws.String(coreNetworkID),
+		SubnetArns:    flex.ExpandStringSet(d.Get("subnet_arns").(*schema.Set)),
+		VpcArn:        aws.String(vpcARN),
+	}
+
+	if v, ok := d.GetOk("options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Options = expandVPCOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager VPC Attachment: %s", input)
+	output, err := conn.CreateVpcAttachmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager VPC (%s) Attachment (%s): %s", vpcARN, coreNetworkID, err)
+	}
+
+	d.SetId(aws.StringValue(output.VpcAttachment.Attachment.AttachmentId))
+
+	if _, err := WaitVPCAttachmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager VPC Attachment (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVPCAttachmentRead(ctx, d, meta)
+}
+
+func resourceVPCAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	vpcAttachment, err := FindVPCAttachmentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager VPC Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager VPC Attachment (%s): %s", d.Id(), err)
+	}
+
+	a := vpcAttachment.Attachment
+	d.Set("arn", attachmentARN(meta.(*conns.AWSClient).Partition, aws.StringValue(a.OwnerAccountId), d.Id()))
+	d.Set("attachment_policy_rule_number", a.AttachmentPolicyRuleNumber)
+	d.Set("attachment_type", a.AttachmentType)
+	d.Set("core_network_arn", a.CoreNetworkArn)
+	d.Set("core_network_id", a.CoreNetworkId)
+	d.Set("edge_location", a.EdgeLocation)
+	if vpcAttachment.Options != nil {
+		if err := d.Set("options", []interface{}{flattenVPCOptions(vpcAttachment.Options)}); err != nil {
+			return diag.Errorf("error setting options: %s", err)
+		}
+	} else {
+		d.Set("options", nil)
+	}
+	d.Set("owner_account_id", a.OwnerAccountId)
+	d.Set("resource_arn", a.ResourceArn)
+	d.Set("segment_name", a.SegmentName)
+	d.Set("state", a.State)
+	d.Set("subnet_arns", aws.StringValueSlice(vpcAttachment.SubnetArns))
+	d.Set("vpc_arn", a.ResourceArn)
+
+	tags := KeyValueTags(a.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVPCAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChanges("options", "subnet_arns") {
+		input := &networkmanager.UpdateVpcAttachmentInput{
+			AttachmentId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("options") {
+			if v, ok := d.GetOk("options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Options = expandVPCOptions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.Options = &networkmanager.VpcOptions{
+					ApplianceModeSupport: aws.Bool(false),
+					Ipv6Support:          aws.Bool(false),
+				}
+			}
+		}
+
+		if d.HasChange("subnet_arns") {
+			o, n := d.GetChange("subnet_arns")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.AddSubnetArns = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.RemoveSubnetArns = flex.ExpandStringSet(del)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager VPC Attachment: %s", input)
+		_, err := conn.UpdateVpcAttachmentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Network Manager VPC Attachment (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitVPCAttachmentUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Network Manager VPC Attachment (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Network Manager VPC Attachment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVPCAttachmentRead(ctx, d, meta)
+}
+
+func resourceVPCAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	// An attachment that has not been accepted must be rejected before it can be deleted.
+	if state := d.Get("state").(string); state == networkmanager.AttachmentStatePendingAttachmentAcceptance || state == networkmanager.AttachmentStatePendingTagAcceptance {
+		if err := rejectAttachment(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := WaitVPCAttachmentRejected(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error waiting for Network Manager VPC Attachment (%s) reject: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager VPC Attachment: %s", d.Id())
+	_, err := conn.DeleteAttachmentWithContext(ctx, &networkmanager.DeleteAttachmentInput{
+		AttachmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager VPC Attachment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitVPCAttachmentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager VPC Attachment (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// attachmentARN returns the ARN of a Cloud WAN core network attachment.
+// Attachments are global resources, so the ARN has no Region.
+func attachmentARN(partition, accountID, id string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   "networkmanager",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("attachment/%s", id),
+	}.String()
+}
+
+func rejectAttachment(ctx context.Context, conn *networkmanager.NetworkManager, id string) error {
+	log.Printf("[DEBUG] Rejecting Network Manager Attachment: %s", id)
+	_, err := conn.RejectAttachmentWithContext(ctx, &networkmanager.RejectAttachmentInput{
+		AttachmentId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error rejecting Network Manager Attachment (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func expandVPCOptions(tfMap map[string]interface{}) *networkmanager.VpcOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.VpcOptions{}
+
+	if v, ok := tfMap["appliance_mode_support"].(bool); ok {
+		apiObject.ApplianceModeSupport = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ipv6_support"].(bool); ok {
+		apiObject.Ipv6Support = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenVPCOptions(apiObject *networkmanager.VpcOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"appliance_mode_support": aws.BoolValue(apiObject.ApplianceModeSupport),
+		"ipv6_support":           aws.BoolValue(apiObject.Ipv6Support),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,418 @@
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func WaitConnectAttachmentAvailable(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.ConnectAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Target:  []string{networkmanager.AttachmentStateAvailable},
+		Refresh: StatusConnectAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitConnectAttachmentCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.ConnectAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Target:  []string{networkmanager.AttachmentStateAvailable, networkmanager.AttachmentStatePendingAttachmentAcceptance},
+		Refresh: StatusConnectAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitConnectAttachmentDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.ConnectAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateDeleting},
+		Target:  []string{},
+		Refresh: StatusConnectAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitConnectAttachmentRejected(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.ConnectAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStateAvailable},
+		Target:  []string{networkmanager.AttachmentStateRejected},
+		Refresh: StatusConnectAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCoreNetworkCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateCreating},
+		Target:  []string{networkmanager.CoreNetworkStateAvailable},
+		Refresh: StatusCoreNetworkState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateDeleting},
+		Target:  []string{},
+		Refresh: StatusCoreNetworkState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateUpdating},
+		Target:  []string{networkmanager.CoreNetworkStateAvailable},
+		Refresh: StatusCoreNetworkState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCoreNetworkPolicyChangeSetExecuted(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ChangeSetStateReadyToExecute, networkmanager.ChangeSetStateExecuting},
+		Target:  []string{networkmanager.ChangeSetStateExecutionSucceeded},
+		Refresh: StatusCoreNetworkPolicyChangeSetState(ctx, conn, coreNetworkID, policyVersionID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		tfresource.SetLastError(err, CoreNetworkPolicyErrors(output.PolicyErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCoreNetworkPolicyChangeSetReadyToExecute(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ChangeSetStatePendingGeneration},
+		Target:  []string{networkmanager.ChangeSetStateReadyToExecute, networkmanager.ChangeSetStateExecutionSucceeded},
+		Refresh: StatusCoreNetworkPolicyChangeSetState(ctx, conn, coreNetworkID, policyVersionID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		tfresource.SetLastError(err, CoreNetworkPolicyErrors(output.PolicyErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitGlobalNetworkCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStatePending},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: StatusGlobalNetworkState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitGlobalNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateDeleting},
+		Target:  []string{},
+		Refresh: StatusGlobalNetworkState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitGlobalNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateUpdating},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: StatusGlobalNetworkState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitSiteToSiteVPNAttachmentAvailable(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.SiteToSiteVpnAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Target:  []string{networkmanager.AttachmentStateAvailable},
+		Refresh: StatusSiteToSiteVPNAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.SiteToSiteVpnAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitSiteToSiteVPNAttachmentCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.SiteToSiteVpnAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Target:  []string{networkmanager.AttachmentStateAvailable, networkmanager.AttachmentStatePendingAttachmentAcceptance},
+		Refresh: StatusSiteToSiteVPNAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.SiteToSiteVpnAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitSiteToSiteVPNAttachmentDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.SiteToSiteVpnAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateDeleting},
+		Target:  []string{},
+		Refresh: StatusSiteToSiteVPNAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.SiteToSiteVpnAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitSiteToSiteVPNAttachmentRejected(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.SiteToSiteVpnAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStateAvailable},
+		Target:  []string{networkmanager.AttachmentStateRejected},
+		Refresh: StatusSiteToSiteVPNAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.SiteToSiteVpnAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCAttachmentAvailable(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.VpcAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Target:  []string{networkmanager.AttachmentStateAvailable},
+		Refresh: StatusVPCAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCAttachmentCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.VpcAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Target:  []string{networkmanager.AttachmentStateAvailable, networkmanager.AttachmentStatePendingAttachmentAcceptance},
+		Refresh: StatusVPCAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCAttachmentDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.VpcAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateDeleting},
+		Target:  []string{},
+		Refresh: StatusVPCAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCAttachmentRejected(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.VpcAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStateAvailable},
+		Target:  []string{networkmanager.AttachmentStateRejected},
+		Refresh: StatusVPCAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCAttachmentUpdated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.VpcAttachment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.AttachmentStateUpdating, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Target:  []string{networkmanager.AttachmentStateAvailable, networkmanager.AttachmentStatePendingTagAcceptance},
+		Refresh: StatusVPCAttachmentState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.VpcAttachment); ok {
+		tfresource.SetLastError(err, AttachmentsError(output.Attachment.LastModificationErrors))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -231,6 +231,26 @@ func ResourceCluster() *schema.Resource {
 				},
 			},
 
+			"serverlessv2_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0.5, 128),
+						},
+						"min_capacity": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0.5, 128),
+						},
+					},
+				},
+			},
+
 			"storage_encrypted": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -537,6 +557,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.BacktrackWindow = aws.Int64(int64(v.(int)))
 		}
 
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			opts.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if attr, ok := d.GetOk("backup_retention_period"); ok {
 			modifyDbClusterInput.BackupRetentionPeriod = aws.Int64(int64(attr.(int)))
 			requiresModifyDbCluster = true
@@ -637,6 +661,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.BacktrackWindow = aws.Int64(int64(v.(int)))
 		}
 
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			createOpts.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v := d.Get("database_name"); v.(string) != "" {
 			createOpts.DatabaseName = aws.String(v.(string))
 		}
@@ -755,6 +783,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.BacktrackWindow = aws.Int64(int64(v.(int)))
 		}
 
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			createOpts.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if attr, ok := d.GetOk("db_subnet_group_name"); ok {
 			createOpts.DBSubnetGroupName = aws.String(attr.(string))
 		}
@@ -854,6 +886,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.BacktrackWindow = aws.Int64(int64(v.(int)))
 		}
 
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			createOpts.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v := d.Get("database_name"); v.(string) != "" {
 			createOpts.DatabaseName = aws.String(v.(string))
 		}
@@ -1103,6 +1139,14 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting scaling_configuration: %s", err)
 	}
 
+	if dbc.ServerlessV2ScalingConfiguration != nil {
+		if err := d.Set("serverlessv2_scaling_configuration", []interface{}{flattenServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting serverlessv2_scaling_configuration: %s", err)
+		}
+	} else {
+		d.Set("serverlessv2_scaling_configuration", nil)
+	}
+
 	d.Set("storage_encrypted", dbc.StorageEncrypted)
 	d.Set("enable_http_endpoint", dbc.HttpEndpointEnabled)
 
@@ -1251,6 +1295,13 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("serverlessv2_scaling_configuration") {
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			requestUpdate = true
+		}
+	}
+
 	if d.HasChange("enable_http_endpoint") {
 		req.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
 		requestUpdate = true
@@ -876,6 +876,52 @@ func TestAccRDSClusterInstance_caCertificateIdentifier(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterInstance_serverlessV2(t *testing.T) {
+	var dbInstance1, dbInstance2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster_instance.test"
+	clusterResourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_ServerlessV2(rName, 64.0, 0.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.serverless"),
+					resource.TestCheckResourceAttr(clusterResourceName, "serverlessv2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(clusterResourceName, "serverlessv2_scaling_configuration.0.max_capacity", "64"),
+					resource.TestCheckResourceAttr(clusterResourceName, "serverlessv2_scaling_configuration.0.min_capacity", "0.5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"identifier_prefix",
+				},
+			},
+			{
+				Config: testAccClusterInstanceConfig_ServerlessV2(rName, 128.0, 8.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(resourceName, &dbInstance2),
+					testAccCheckInstanceNotRecreated(&dbInstance1, &dbInstance2),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.serverless"),
+					resource.TestCheckResourceAttr(clusterResourceName, "serverlessv2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(clusterResourceName, "serverlessv2_scaling_configuration.0.max_capacity", "128"),
+					resource.TestCheckResourceAttr(clusterResourceName, "serverlessv2_scaling_configuration.0.min_capacity", "8"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRDSPerformanceInsightsDefaultVersionPreCheck(t *testing.T, engine string) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
 
@@ -1752,3 +1798,18 @@ resource "aws_rds_cluster_instance" "test" {
 }
 `, rName)
 }
+
+func testAccClusterInstanceConfig_ServerlessV2(rName string, maxCapacity, minCapacity float64) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_ServerlessV2ScalingConfiguration(rName, maxCapacity, minCapacity),
+		fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test" {
+  apply_immediately  = true
+  cluster_identifier = aws_rds_cluster.test.id
+  identifier         = %[1]q
+  instance_class     = "db.serverless"
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+}
+`, rName))
+}
@@ -0,0 +1,221 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceEngineVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEngineVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"engine_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_group_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supports_read_replica": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"valid_upgrade_targets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"include_all": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"parameter_group_family": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"preferred_versions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"supports_read_replica": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"upgrade_from_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEngineVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	engine := d.Get("engine").(string)
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine: aws.String(engine),
+	}
+
+	if v, ok := d.GetOk("include_all"); ok {
+		input.IncludeAll = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("parameter_group_family"); ok {
+		input.DBParameterGroupFamily = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Reading RDS engine versions: %v", input)
+	engineVersions, err := findDBEngineVersions(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS engine versions: %w", err)
+	}
+
+	// Only keep the valid upgrade targets of the given version.
+	if v, ok := d.GetOk("upgrade_from_version"); ok {
+		sources, err := findDBEngineVersions(conn, &rds.DescribeDBEngineVersionsInput{
+			Engine:        aws.String(engine),
+			EngineVersion: aws.String(v.(string)),
+			IncludeAll:    aws.Bool(true),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading RDS engine version (%s): %w", v.(string), err)
+		}
+
+		upgradeTargets := make(map[string]bool)
+		for _, source := range sources {
+			for _, target := range source.ValidUpgradeTarget {
+				upgradeTargets[aws.StringValue(target.EngineVersion)] = true
+			}
+		}
+
+		var filtered []*rds.DBEngineVersion
+		for _, engineVersion := range engineVersions {
+			if upgradeTargets[aws.StringValue(engineVersion.EngineVersion)] {
+				filtered = append(filtered, engineVersion)
+			}
+		}
+		engineVersions = filtered
+	}
+
+	if v, ok := d.GetOk("supports_read_replica"); ok {
+		var filtered []*rds.DBEngineVersion
+		for _, engineVersion := range engineVersions {
+			if aws.BoolValue(engineVersion.SupportsReadReplica) == v.(bool) {
+				filtered = append(filtered, engineVersion)
+			}
+		}
+		engineVersions = filtered
+	}
+
+	// Only keep the preferred versions, in order of preference.
+	if l := d.Get("preferred_versions").([]interface{}); len(l) > 0 {
+		var ordered []*rds.DBEngineVersion
+		for _, elem := range l {
+			preferredVersion, ok := elem.(string)
+
+			if !ok {
+				continue
+			}
+
+			for _, engineVersion := range engineVersions {
+				if preferredVersion == aws.StringValue(engineVersion.EngineVersion) {
+					ordered = append(ordered, engineVersion)
+					break
+				}
+			}
+		}
+		engineVersions = ordered
+	}
+
+	var tfList []interface{}
+	var versions []string
+
+	for _, engineVersion := range engineVersions {
+		var upgradeTargets []string
+		for _, ut := range engineVersion.ValidUpgradeTarget {
+			upgradeTargets = append(upgradeTargets, aws.StringValue(ut.EngineVersion))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter_group_family": aws.StringValue(engineVersion.DBParameterGroupFamily),
+			"status":                 aws.StringValue(engineVersion.Status),
+			"supports_read_replica":  aws.BoolValue(engineVersion.SupportsReadReplica),
+			"valid_upgrade_targets":  upgradeTargets,
+			"version":                aws.StringValue(engineVersion.EngineVersion),
+			"version_description":    aws.StringValue(engineVersion.DBEngineVersionDescription),
+		})
+		versions = append(versions, aws.StringValue(engineVersion.EngineVersion))
+	}
+
+	d.SetId(engine)
+
+	if err := d.Set("engine_versions", tfList); err != nil {
+		return fmt.Errorf("error setting engine_versions: %w", err)
+	}
+
+	d.Set("versions", versions)
+
+	return nil
+}
+
+func findDBEngineVersions(conn *rds.RDS, input *rds.DescribeDBEngineVersionsInput) ([]*rds.DBEngineVersion, error) {
+	var output []*rds.DBEngineVersion
+
+	err := conn.DescribeDBEngineVersionsPages(input, func(page *rds.DescribeDBEngineVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBEngineVersions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return output, err
+}
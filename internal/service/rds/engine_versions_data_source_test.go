@@ -0,0 +1,106 @@
+package rds_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSEngineVersionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccEngineVersionPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsBasicDataSourceConfig("mysql", "mysql8.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine", "mysql"),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexp.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.0", regexp.MustCompile(`^8\.0\.`)),
+					resource.TestCheckResourceAttr(dataSourceName, "engine_versions.0.parameter_group_family", "mysql8.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "engine_versions.0.supports_read_replica", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "engine_versions.0.version", dataSourceName, "versions.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSEngineVersionsDataSource_preferred(t *testing.T) {
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccEngineVersionPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsPreferredDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0", "8.0.28"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1", "8.0.23"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSEngineVersionsDataSource_upgradeFromVersion(t *testing.T) {
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccEngineVersionPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsUpgradeFromVersionDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexp.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.0", regexp.MustCompile(`^8\.0\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccEngineVersionsBasicDataSourceConfig(engine, paramGroup string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_versions" "test" {
+  engine                 = %[1]q
+  parameter_group_family = %[2]q
+  supports_read_replica  = true
+}
+`, engine, paramGroup)
+}
+
+func testAccEngineVersionsPreferredDataSourceConfig() string {
+	return `
+data "aws_rds_engine_versions" "test" {
+  engine             = "mysql"
+  include_all        = true
+  preferred_versions = ["85.9.12", "8.0.28", "8.0.23"]
+}
+`
+}
+
+func testAccEngineVersionsUpgradeFromVersionDataSourceConfig() string {
+	return `
+data "aws_rds_engine_versions" "test" {
+  engine                 = "mysql"
+  parameter_group_family = "mysql8.0"
+  upgrade_from_version   = "5.7.37"
+}
+`
+}
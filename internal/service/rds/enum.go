@@ -22,6 +22,23 @@ const (
 	InstanceStatusStorageOptimization           = "storage-optimization"
 )
 
+const (
+	BlueGreenDeploymentStatusAvailable            = "AVAILABLE"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
+
 const (
 	EventSubscriptionStatusActive    = "active"
 	EventSubscriptionStatusCreating  = "creating"
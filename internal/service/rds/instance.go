@@ -118,6 +118,19 @@ func ResourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"ca_cert_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1748,7 +1761,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Send DB Instance Modification request: %t", requestUpdate)
-	if requestUpdate {
+	if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(instanceBlueGreenUpdateAttributes...) {
+		if err := instanceBlueGreenUpdate(conn, d, req, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	} else if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
 
 		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
@@ -0,0 +1,204 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceBlueGreenUpdateAttributes are the arguments whose change triggers a
+// Blue/Green Deployment when blue_green_update is enabled.
+var instanceBlueGreenUpdateAttributes = []string{
+	"engine_version",
+	"instance_class",
+	"parameter_group_name",
+}
+
+// instanceBlueGreenUpdate replaces the DB instance with an updated copy via an
+// RDS Blue/Green Deployment. The green environment is created with the new engine
+// version, instance class and parameter group and any other pending modifications
+// are applied to it. On switchover the green instance takes over the original
+// identifier, after which the deployment and the old (blue) instance are deleted.
+func instanceBlueGreenUpdate(conn *rds.RDS, d *schema.ResourceData, modifyInput *rds.ModifyDBInstanceInput, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	if d.Get("backup_retention_period").(int) == 0 {
+		return fmt.Errorf("error updating DB Instance (%s): Blue/Green Deployments require automated backups, backup_retention_period must be greater than 0", d.Id())
+	}
+
+	createInput := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("engine_version") {
+		createInput.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	if d.HasChange("instance_class") {
+		createInput.TargetDBInstanceClass = aws.String(d.Get("instance_class").(string))
+	}
+
+	if d.HasChange("parameter_group_name") {
+		createInput.TargetDBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+	}
+
+	log.Printf("[DEBUG] Creating RDS Blue/Green Deployment: %s", createInput)
+	createOutput, err := conn.CreateBlueGreenDeployment(createInput)
+
+	if err != nil {
+		return fmt.Errorf("error creating Blue/Green Deployment for DB Instance (%s): %w", d.Id(), err)
+	}
+
+	deploymentID := aws.StringValue(createOutput.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+	switchedOver := false
+
+	defer func() {
+		if switchedOver {
+			return
+		}
+
+		// Clean up the green environment if anything failed before switchover.
+		log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment (%s) and its target", deploymentID)
+		_, err := conn.DeleteBlueGreenDeployment(&rds.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+			DeleteTarget:                  aws.Bool(true),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] error deleting Blue/Green Deployment (%s): %s", deploymentID, err)
+		}
+	}()
+
+	deployment, err := waitBlueGreenDeploymentAvailable(conn, deploymentID, time.Until(deadline))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Blue/Green Deployment (%s) create: %w", deploymentID, err)
+	}
+
+	if d.HasChangesExcept(append(instanceBlueGreenUpdateAttributes,
+		"allow_major_version_upgrade",
+		"apply_immediately",
+		"blue_green_update",
+		"delete_automated_backups",
+		"final_snapshot_identifier",
+		"replicate_source_db",
+		"skip_final_snapshot",
+		"tags",
+		"tags_all",
+	)...) {
+		targetID, err := instanceIdentifierFromARN(aws.StringValue(deployment.Target))
+
+		if err != nil {
+			return err
+		}
+
+		// The green instance already runs the new engine version, class and parameter group.
+		modifyInput.AllowMajorVersionUpgrade = nil
+		modifyInput.ApplyImmediately = aws.Bool(true)
+		modifyInput.DBInstanceClass = nil
+		modifyInput.DBInstanceIdentifier = aws.String(targetID)
+		modifyInput.DBParameterGroupName = nil
+		modifyInput.EngineVersion = nil
+
+		log.Printf("[DEBUG] Modifying Blue/Green Deployment (%s) target DB Instance: %s", deploymentID, modifyInput)
+		if _, err := conn.ModifyDBInstance(modifyInput); err != nil {
+			return fmt.Errorf("error modifying Blue/Green Deployment (%s) target DB Instance (%s): %w", deploymentID, targetID, err)
+		}
+
+		if err := waitUntilDBInstanceAvailableAfterUpdate(targetID, conn, time.Until(deadline)); err != nil {
+			return fmt.Errorf("error waiting for Blue/Green Deployment (%s) target DB Instance (%s) to be available: %w", deploymentID, targetID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment (%s)", deploymentID)
+	_, err = conn.SwitchoverBlueGreenDeployment(&rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error switching over Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	switchedOver = true
+
+	deployment, err = waitBlueGreenDeploymentSwitchoverCompleted(conn, deploymentID, time.Until(deadline))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Blue/Green Deployment (%s) switchover: %w", deploymentID, err)
+	}
+
+	sourceID, err := instanceIdentifierFromARN(aws.StringValue(deployment.Source))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment (%s)", deploymentID)
+	_, err = conn.DeleteBlueGreenDeployment(&rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return fmt.Errorf("error deleting Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(conn, deploymentID, time.Until(deadline)); err != nil {
+		return fmt.Errorf("error waiting for Blue/Green Deployment (%s) delete: %w", deploymentID, err)
+	}
+
+	if d.Get("deletion_protection").(bool) {
+		input := &rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(sourceID),
+			DeletionProtection:   aws.Bool(false),
+		}
+
+		if _, err := conn.ModifyDBInstance(input); err != nil {
+			return fmt.Errorf("error disabling deletion protection on Blue/Green Deployment (%s) source DB Instance (%s): %w", deploymentID, sourceID, err)
+		}
+
+		if err := waitUntilDBInstanceAvailableAfterUpdate(sourceID, conn, time.Until(deadline)); err != nil {
+			return fmt.Errorf("error waiting for Blue/Green Deployment (%s) source DB Instance (%s) to be available: %w", deploymentID, sourceID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Blue/Green Deployment (%s) source DB Instance (%s)", deploymentID, sourceID)
+	_, err = conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(sourceID),
+		SkipFinalSnapshot:    aws.Bool(true),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return fmt.Errorf("error deleting Blue/Green Deployment (%s) source DB Instance (%s): %w", deploymentID, sourceID, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(conn, sourceID, time.Until(deadline)); err != nil {
+		return fmt.Errorf("error waiting for Blue/Green Deployment (%s) source DB Instance (%s) delete: %w", deploymentID, sourceID, err)
+	}
+
+	return nil
+}
+
+// instanceIdentifierFromARN returns the DB instance identifier from a DB instance ARN,
+// e.g. arn:aws:rds:us-west-2:123456789012:db:my-instance.
+func instanceIdentifierFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing DB Instance ARN (%s): %w", s, err)
+	}
+
+	if !strings.HasPrefix(v.Resource, "db:") {
+		return "", fmt.Errorf("unexpected DB Instance ARN resource (%s)", v.Resource)
+	}
+
+	return strings.TrimPrefix(v.Resource, "db:"), nil
+}
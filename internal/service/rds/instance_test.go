@@ -312,6 +312,40 @@ func TestAccRDSInstance_allowMajorVersionUpgrade(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
+	var v1, v2 rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_engineVersion(rName, "5.7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.initial", "version"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_engineVersion(rName, "8.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.initial", "version"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_dbSubnetGroupName(t *testing.T) {
 	var dbInstance rds.DBInstance
 	var dbSubnetGroup rds.DBSubnetGroup
@@ -2887,6 +2921,15 @@ func testAccCheckInstanceNotRecreated(instance1, instance2 *rds.DBInstance) reso
 	}
 }
 
+func testAccCheckInstanceRecreated(instance1, instance2 *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(instance1.DbiResourceId) == aws.StringValue(instance2.DbiResourceId) {
+			return fmt.Errorf("database instance was not recreated: %s", aws.StringValue(instance1.DbiResourceId))
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -4912,6 +4955,39 @@ resource "aws_db_instance" "test" {
 `, allowMajorVersionUpgrade, rName))
 }
 
+func testAccInstanceConfig_BlueGreenDeployment_engineVersion(rName, majorVersion string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "initial" {
+  engine             = "mysql"
+  preferred_versions = ["%[2]s"]
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.initial.engine
+  engine_version             = data.aws_rds_engine_version.initial.version
+  preferred_instance_classes = ["db.t3.micro", "db.t3.small"]
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage           = 10
+  allow_major_version_upgrade = true
+  apply_immediately           = true
+  backup_retention_period     = 1
+  engine                      = data.aws_rds_engine_version.initial.engine
+  engine_version              = data.aws_rds_engine_version.initial.version
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  password                    = "avoid-plaintext-passwords"
+  skip_final_snapshot         = true
+  username                    = "tfacctest"
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, majorVersion)
+}
+
 func testAccInstanceConfig_AutoMinorVersion() string {
 	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
 resource "aws_db_instance" "bar" {
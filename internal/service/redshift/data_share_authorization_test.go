@@ -0,0 +1,155 @@
+package redshift_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Data shares can only be created with SQL, so an existing producer data share
+// must be supplied via the REDSHIFT_DATA_SHARE_ARN environment variable.
+func testAccDataSharePreCheck(t *testing.T) string {
+	dataShareARN := os.Getenv("REDSHIFT_DATA_SHARE_ARN")
+
+	if dataShareARN == "" {
+		t.Skip("Environment variable REDSHIFT_DATA_SHARE_ARN is not set")
+	}
+
+	return dataShareARN
+}
+
+func TestAccRedshiftDataShareAuthorization_basic(t *testing.T) {
+	dataShareARN := testAccDataSharePreCheck(t)
+	resourceName := "aws_redshift_data_share_authorization.test"
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckDataShareAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_share_arn", dataShareARN),
+					resource.TestCheckResourceAttrPair(resourceName, "consumer_identifier", "data.aws_caller_identity.consumer", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "allow_writes", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "producer_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareAuthorization_disappears(t *testing.T) {
+	dataShareARN := testAccDataSharePreCheck(t)
+	resourceName := "aws_redshift_data_share_authorization.test"
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckDataShareAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceDataShareAuthorization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareAuthorizationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_data_share_authorization" {
+			continue
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, _, err = tfredshift.FindDataShareAuthorizationByID(conn, dataShareARN, consumerIdentifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Data Share Authorization %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataShareAuthorizationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Authorization ID is set")
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		_, _, err = tfredshift.FindDataShareAuthorizationByID(conn, dataShareARN, consumerIdentifier)
+
+		return err
+	}
+}
+
+func testAccDataShareAuthorizationConfig(dataShareARN string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "consumer" {
+  provider = "awsalternate"
+}
+
+resource "aws_redshift_data_share_authorization" "test" {
+  data_share_arn      = %[1]q
+  consumer_identifier = data.aws_caller_identity.consumer.account_id
+}
+`, dataShareARN))
+}
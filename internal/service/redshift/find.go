@@ -2,6 +2,7 @@ package redshift
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -65,3 +66,95 @@ func FindScheduledActionByName(conn *redshift.Redshift, name string) (*redshift.
 
 	return output.ScheduledActions[0], nil
 }
+
+func FindDataShareByARN(conn *redshift.Redshift, dataShareARN string) (*redshift.DataShare, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	output, err := conn.DescribeDataShares(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DataShares) == 0 || output.DataShares[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DataShares); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.DataShares[0], nil
+}
+
+func FindDataShareAuthorizationByID(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	dataShare, err := FindDataShareByARN(conn, dataShareARN)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if v == nil || aws.StringValue(v.ConsumerIdentifier) != consumerIdentifier {
+			continue
+		}
+
+		// A deauthorized consumer is retained in the association list.
+		if status := aws.StringValue(v.Status); status == redshift.DataShareStatusDeauthorized {
+			return nil, nil, &resource.NotFoundError{
+				Message:     status,
+				LastRequest: dataShareARN,
+			}
+		}
+
+		return dataShare, v, nil
+	}
+
+	return nil, nil, &resource.NotFoundError{
+		LastRequest: dataShareARN,
+	}
+}
+
+func FindDataShareConsumerAssociationByID(conn *redshift.Redshift, dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	dataShare, err := FindDataShareByARN(conn, dataShareARN)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if v == nil {
+			continue
+		}
+
+		switch {
+		case consumerARN != "":
+			if aws.StringValue(v.ConsumerIdentifier) != consumerARN {
+				continue
+			}
+		case consumerRegion != "":
+			if aws.StringValue(v.ConsumerRegion) != consumerRegion {
+				continue
+			}
+		case associateEntireAccount:
+			if arn.IsARN(aws.StringValue(v.ConsumerIdentifier)) || aws.StringValue(v.ConsumerRegion) != "" {
+				continue
+			}
+		}
+
+		return dataShare, v, nil
+	}
+
+	return nil, nil, &resource.NotFoundError{
+		LastRequest: dataShareARN,
+	}
+}
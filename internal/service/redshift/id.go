@@ -0,0 +1,49 @@
+package redshift
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const dataShareAuthorizationResourceIDSeparator = ","
+
+func DataShareAuthorizationCreateResourceID(dataShareARN, consumerIdentifier string) string {
+	parts := []string{dataShareARN, consumerIdentifier}
+	id := strings.Join(parts, dataShareAuthorizationResourceIDSeparator)
+
+	return id
+}
+
+func DataShareAuthorizationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, dataShareAuthorizationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DATA_SHARE_ARN%[2]sCONSUMER_IDENTIFIER", id, dataShareAuthorizationResourceIDSeparator)
+}
+
+const dataShareConsumerAssociationResourceIDSeparator = ","
+
+func DataShareConsumerAssociationCreateResourceID(dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion string) string {
+	parts := []string{dataShareARN, strconv.FormatBool(associateEntireAccount), consumerARN, consumerRegion}
+	id := strings.Join(parts, dataShareConsumerAssociationResourceIDSeparator)
+
+	return id
+}
+
+func DataShareConsumerAssociationParseResourceID(id string) (string, bool, string, string, error) {
+	parts := strings.Split(id, dataShareConsumerAssociationResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" {
+		associateEntireAccount, err := strconv.ParseBool(parts[1])
+
+		if err == nil && (associateEntireAccount || parts[2] != "" || parts[3] != "") {
+			return parts[0], associateEntireAccount, parts[2], parts[3], nil
+		}
+	}
+
+	return "", false, "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DATA_SHARE_ARN%[2]sASSOCIATE_ENTIRE_ACCOUNT%[2]sCONSUMER_ARN%[2]sCONSUMER_REGION", id, dataShareConsumerAssociationResourceIDSeparator)
+}
@@ -0,0 +1,91 @@
+package redshift_test
+
+import (
+	"testing"
+
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+)
+
+func TestDataShareConsumerAssociationParseResourceID(t *testing.T) {
+	dataShareARN := "arn:aws:redshift:us-west-2:123456789012:datashare:b3bfde75-73fd-408b-9086-d6fccfd6d588/example" //lintignore:AWSAT003,AWSAT005
+	consumerARN := "arn:aws:redshift:us-west-2:123456789012:namespace:f6dd1b1b-ec8c-4a34-9c82-1f3e3fbb45a1"          //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		TestName                       string
+		InputID                        string
+		ExpectedError                  bool
+		ExpectedDataShareARN           string
+		ExpectedAssociateEntireAccount bool
+		ExpectedConsumerARN            string
+		ExpectedConsumerRegion         string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       dataShareARN,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "no consumer",
+			InputID:       dataShareARN + ",false,,",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid boolean",
+			InputID:       dataShareARN + ",yes,,",
+			ExpectedError: true,
+		},
+		{
+			TestName:                       "entire account",
+			InputID:                        dataShareARN + ",true,,",
+			ExpectedDataShareARN:           dataShareARN,
+			ExpectedAssociateEntireAccount: true,
+		},
+		{
+			TestName:             "consumer ARN",
+			InputID:              dataShareARN + ",false," + consumerARN + ",",
+			ExpectedDataShareARN: dataShareARN,
+			ExpectedConsumerARN:  consumerARN,
+		},
+		{
+			TestName:               "consumer Region",
+			InputID:                dataShareARN + ",false,,us-west-2", //lintignore:AWSAT003
+			ExpectedDataShareARN:   dataShareARN,
+			ExpectedConsumerRegion: "us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotDataShareARN, gotAssociateEntireAccount, gotConsumerARN, gotConsumerRegion, err := tfredshift.DataShareConsumerAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotDataShareARN != testCase.ExpectedDataShareARN {
+				t.Errorf("got data share ARN %s, expected %s", gotDataShareARN, testCase.ExpectedDataShareARN)
+			}
+
+			if gotAssociateEntireAccount != testCase.ExpectedAssociateEntireAccount {
+				t.Errorf("got associate entire account %t, expected %t", gotAssociateEntireAccount, testCase.ExpectedAssociateEntireAccount)
+			}
+
+			if gotConsumerARN != testCase.ExpectedConsumerARN {
+				t.Errorf("got consumer ARN %s, expected %s", gotConsumerARN, testCase.ExpectedConsumerARN)
+			}
+
+			if gotConsumerRegion != testCase.ExpectedConsumerRegion {
+				t.Errorf("got consumer Region %s, expected %s", gotConsumerRegion, testCase.ExpectedConsumerRegion)
+			}
+		})
+	}
+}
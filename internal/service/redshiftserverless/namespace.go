@@ -0,0 +1,295 @@
+package redshiftserverless
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNamespaceCreate,
+		ReadContext:   resourceNamespaceRead,
+		UpdateContext: resourceNamespaceUpdate,
+		DeleteContext: resourceNamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"admin_user_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"admin_username"},
+			},
+			"admin_username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"admin_user_password"},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"default_iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"iam_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"log_exports": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(redshiftserverless.LogExport_Values(), false),
+				},
+			},
+			"namespace_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("namespace_name").(string)
+	input := &redshiftserverless.CreateNamespaceInput{
+		NamespaceName: aws.String(name),
+		Tags:          Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("admin_user_password"); ok {
+		input.AdminUserPassword = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("admin_username"); ok {
+		input.AdminUsername = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_name"); ok {
+		input.DbName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_iam_role_arn"); ok {
+		input.DefaultIamRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("iam_roles"); ok && v.(*schema.Set).Len() > 0 {
+		input.IamRoles = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_exports"); ok && v.(*schema.Set).Len() > 0 {
+		input.LogExports = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	_, err := conn.CreateNamespaceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Redshift Serverless Namespace (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitNamespaceAvailable(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for Redshift Serverless Namespace (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceNamespaceRead(ctx, d, meta)
+}
+
+func resourceNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindNamespaceByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Serverless Namespace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Redshift Serverless Namespace (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.NamespaceArn)
+	d.Set("admin_username", output.AdminUsername)
+	d.Set("arn", arn)
+	d.Set("db_name", output.DbName)
+	d.Set("default_iam_role_arn", output.DefaultIamRoleArn)
+	d.Set("iam_roles", flattenNamespaceIAMRoles(output.IamRoles))
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("log_exports", aws.StringValueSlice(output.LogExports))
+	d.Set("namespace_id", output.NamespaceId)
+	d.Set("namespace_name", output.NamespaceName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Redshift Serverless Namespace (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &redshiftserverless.UpdateNamespaceInput{
+			NamespaceName: aws.String(d.Id()),
+		}
+
+		// The admin user name and password must be updated together.
+		if d.HasChanges("admin_user_password", "admin_username") {
+			input.AdminUserPassword = aws.String(d.Get("admin_user_password").(string))
+			input.AdminUsername = aws.String(d.Get("admin_username").(string))
+		}
+
+		// The default IAM role must be one of the namespace's IAM roles.
+		if d.HasChanges("default_iam_role_arn", "iam_roles") {
+			input.DefaultIamRoleArn = aws.String(d.Get("default_iam_role_arn").(string))
+			input.IamRoles = flex.ExpandStringSet(d.Get("iam_roles").(*schema.Set))
+		}
+
+		if d.HasChange("kms_key_id") {
+			input.KmsKeyId = aws.String(d.Get("kms_key_id").(string))
+		}
+
+		if d.HasChange("log_exports") {
+			input.LogExports = flex.ExpandStringSet(d.Get("log_exports").(*schema.Set))
+		}
+
+		_, err := conn.UpdateNamespaceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Redshift Serverless Namespace (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitNamespaceAvailable(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error waiting for Redshift Serverless Namespace (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Redshift Serverless Namespace (%s) tags: %s", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceNamespaceRead(ctx, d, meta)
+}
+
+func resourceNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	log.Printf("[DEBUG] Deleting Redshift Serverless Namespace: %s", d.Id())
+	// The namespace can't be deleted while one of its workgroups is being deleted.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, namespaceDeletedTimeout, func() (interface{}, error) {
+		return conn.DeleteNamespaceWithContext(ctx, &redshiftserverless.DeleteNamespaceInput{
+			NamespaceName: aws.String(d.Id()),
+		})
+	}, redshiftserverless.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Redshift Serverless Namespace (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitNamespaceDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for Redshift Serverless Namespace (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// flattenNamespaceIAMRoles returns the IAM role ARNs of a namespace.
+// The API returns each role as "IamRole(applyStatus=in-sync, iamRoleArn=arn:...)".
+func flattenNamespaceIAMRoles(apiObjects []*string) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		v := aws.StringValue(apiObject)
+
+		if i := strings.Index(v, "iamRoleArn="); i >= 0 {
+			v = strings.TrimSuffix(v[i+len("iamRoleArn="):], ")")
+		}
+
+		tfList = append(tfList, v)
+	}
+
+	return tfList
+}
@@ -0,0 +1,337 @@
+package redshiftserverless_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftServerlessNamespace_basic(t *testing.T) {
+	resourceName := "aws_redshiftserverless_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "redshift-serverless", regexp.MustCompile("namespace/.+$")),
+					resource.TestCheckResourceAttr(resourceName, "namespace_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_id"),
+					resource.TestCheckResourceAttr(resourceName, "iam_roles.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "log_exports.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessNamespace_user(t *testing.T) {
+	resourceName := "aws_redshiftserverless_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfigUser(rName, "admin", "Password123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_username", "admin"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_user_password"},
+			},
+			{
+				Config: testAccNamespaceConfigUser(rName, "admin", "Password1234"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_username", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessNamespace_iamRoles(t *testing.T) {
+	resourceName := "aws_redshiftserverless_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfigIAMRoles(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "iam_roles.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "iam_roles.*", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessNamespace_logExports(t *testing.T) {
+	resourceName := "aws_redshiftserverless_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfigLogExports(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_exports.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_exports.*", "userlog"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_exports.*", "connectionlog"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNamespaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_exports.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessNamespace_tags(t *testing.T) {
+	resourceName := "aws_redshiftserverless_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNamespaceConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNamespaceConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessNamespace_disappears(t *testing.T) {
+	resourceName := "aws_redshiftserverless_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshiftserverless.ResourceNamespace(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNamespaceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshiftserverless_namespace" {
+			continue
+		}
+
+		_, err := tfredshiftserverless.FindNamespaceByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Serverless Namespace %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckNamespaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Serverless Namespace ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+		_, err := tfredshiftserverless.FindNamespaceByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccNamespaceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+`, rName)
+}
+
+func testAccNamespaceConfigUser(rName, username, password string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name      = %[1]q
+  admin_username      = %[2]q
+  admin_user_password = %[3]q
+}
+`, rName, username, password)
+}
+
+func testAccNamespaceConfigIAMRoles(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "redshift.${data.aws_partition.current.dns_suffix}"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name       = %[1]q
+  default_iam_role_arn = aws_iam_role.test.arn
+  iam_roles            = [aws_iam_role.test.arn]
+}
+`, rName)
+}
+
+func testAccNamespaceConfigLogExports(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+  log_exports    = ["userlog", "connectionlog"]
+}
+`, rName)
+}
+
+func testAccNamespaceConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccNamespaceConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
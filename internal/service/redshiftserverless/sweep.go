@@ -0,0 +1,116 @@
+//go:build sweep
+// +build sweep
+
+package redshiftserverless
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_redshiftserverless_namespace", &resource.Sweeper{
+		Name: "aws_redshiftserverless_namespace",
+		F:    sweepNamespaces,
+		Dependencies: []string{
+			"aws_redshiftserverless_workgroup",
+		},
+	})
+
+	resource.AddTestSweepers("aws_redshiftserverless_workgroup", &resource.Sweeper{
+		Name: "aws_redshiftserverless_workgroup",
+		F:    sweepWorkgroups,
+	})
+}
+
+func sweepNamespaces(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).RedshiftServerlessConn
+	input := &redshiftserverless.ListNamespacesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListNamespacesPages(input, func(page *redshiftserverless.ListNamespacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Namespaces {
+			r := ResourceNamespace()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.NamespaceName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Redshift Serverless Namespace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Redshift Serverless Namespaces (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Redshift Serverless Namespaces (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepWorkgroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).RedshiftServerlessConn
+	input := &redshiftserverless.ListWorkgroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListWorkgroupsPages(input, func(page *redshiftserverless.ListWorkgroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Workgroups {
+			r := ResourceWorkgroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.WorkgroupName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Redshift Serverless Workgroup sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Redshift Serverless Workgroups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Redshift Serverless Workgroups (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package redshiftserverless
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUsageLimit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUsageLimitCreate,
+		ReadContext:   resourceUsageLimitRead,
+		UpdateContext: resourceUsageLimitUpdate,
+		DeleteContext: resourceUsageLimitDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"amount": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"breach_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      redshiftserverless.UsageLimitBreachActionLog,
+				ValidateFunc: validation.StringInSlice(redshiftserverless.UsageLimitBreachAction_Values(), false),
+			},
+			"period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      redshiftserverless.UsageLimitPeriodMonthly,
+				ValidateFunc: validation.StringInSlice(redshiftserverless.UsageLimitPeriod_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"usage_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(redshiftserverless.UsageLimitUsageType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceUsageLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	input := &redshiftserverless.CreateUsageLimitInput{
+		Amount:       aws.Int64(int64(d.Get("amount").(int))),
+		BreachAction: aws.String(d.Get("breach_action").(string)),
+		Period:       aws.String(d.Get("period").(string)),
+		ResourceArn:  aws.String(d.Get("resource_arn").(string)),
+		UsageType:    aws.String(d.Get("usage_type").(string)),
+	}
+
+	output, err := conn.CreateUsageLimitWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Redshift Serverless Usage Limit: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.UsageLimit.UsageLimitId))
+
+	return resourceUsageLimitRead(ctx, d, meta)
+}
+
+func resourceUsageLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	output, err := FindUsageLimitByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Serverless Usage Limit (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Redshift Serverless Usage Limit (%s): %s", d.Id(), err)
+	}
+
+	d.Set("amount", output.Amount)
+	d.Set("arn", output.UsageLimitArn)
+	d.Set("breach_action", output.BreachAction)
+	d.Set("period", output.Period)
+	d.Set("resource_arn", output.ResourceArn)
+	d.Set("usage_type", output.UsageType)
+
+	return nil
+}
+
+func resourceUsageLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	input := &redshiftserverless.UpdateUsageLimitInput{
+		UsageLimitId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("amount") {
+		input.Amount = aws.Int64(int64(d.Get("amount").(int)))
+	}
+
+	if d.HasChange("breach_action") {
+		input.BreachAction = aws.String(d.Get("breach_action").(string))
+	}
+
+	_, err := conn.UpdateUsageLimitWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Redshift Serverless Usage Limit (%s): %s", d.Id(), err)
+	}
+
+	return resourceUsageLimitRead(ctx, d, meta)
+}
+
+func resourceUsageLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	log.Printf("[DEBUG] Deleting Redshift Serverless Usage Limit: %s", d.Id())
+	_, err := conn.DeleteUsageLimitWithContext(ctx, &redshiftserverless.DeleteUsageLimitInput{
+		UsageLimitId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Redshift Serverless Usage Limit (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package redshiftserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftServerlessUsageLimit_basic(t *testing.T) {
+	resourceName := "aws_redshiftserverless_usage_limit.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUsageLimitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageLimitConfig(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_redshiftserverless_workgroup.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "amount", "60"),
+					resource.TestCheckResourceAttr(resourceName, "breach_action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "period", "monthly"),
+					resource.TestCheckResourceAttr(resourceName, "usage_type", "serverless-compute"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsageLimitConfig(rName, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "amount", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessUsageLimit_disappears(t *testing.T) {
+	resourceName := "aws_redshiftserverless_usage_limit.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUsageLimitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageLimitConfig(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshiftserverless.ResourceUsageLimit(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUsageLimitDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshiftserverless_usage_limit" {
+			continue
+		}
+
+		_, err := tfredshiftserverless.FindUsageLimitByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Serverless Usage Limit %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckUsageLimitExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Serverless Usage Limit ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+		_, err := tfredshiftserverless.FindUsageLimitByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccUsageLimitConfig(rName string, amount int) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftserverless_usage_limit" "test" {
+  resource_arn = aws_redshiftserverless_workgroup.test.arn
+  usage_type   = "serverless-compute"
+  amount       = %[2]d
+}
+`, rName, amount)
+}
@@ -0,0 +1,86 @@
+package redshiftserverless
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	namespaceAvailableTimeout = 10 * time.Minute
+	namespaceDeletedTimeout   = 10 * time.Minute
+)
+
+// waitNamespaceAvailable waits for a Redshift Serverless Namespace to become available after modifications.
+func waitNamespaceAvailable(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Namespace, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{redshiftserverless.NamespaceStatusModifying},
+		Target:  []string{redshiftserverless.NamespaceStatusAvailable},
+		Refresh: statusNamespace(ctx, conn, name),
+		Timeout: namespaceAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitNamespaceDeleted waits for a Redshift Serverless Namespace to be deleted.
+func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Namespace, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{redshiftserverless.NamespaceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusNamespace(ctx, conn, name),
+		Timeout: namespaceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitWorkgroupAvailable waits for a Redshift Serverless Workgroup to become available after creation or modifications.
+func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string, timeout time.Duration) (*redshiftserverless.Workgroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{redshiftserverless.WorkgroupStatusCreating, redshiftserverless.WorkgroupStatusModifying},
+		Target:  []string{redshiftserverless.WorkgroupStatusAvailable},
+		Refresh: statusWorkgroup(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitWorkgroupDeleted waits for a Redshift Serverless Workgroup to be deleted.
+func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string, timeout time.Duration) (*redshiftserverless.Workgroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{redshiftserverless.WorkgroupStatusAvailable, redshiftserverless.WorkgroupStatusDeleting},
+		Target:  []string{},
+		Refresh: statusWorkgroup(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
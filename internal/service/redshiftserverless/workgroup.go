@@ -0,0 +1,479 @@
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkgroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkgroupCreate,
+		ReadContext:   resourceWorkgroupRead,
+		UpdateContext: resourceWorkgroupUpdate,
+		DeleteContext: resourceWorkgroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"config_parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"auto_mv",
+								"datestyle",
+								"enable_case_sensitive_identifier",
+								"enable_user_activity_logging",
+								"max_query_execution_time",
+								"query_group",
+								"search_path",
+							}, false),
+						},
+						"parameter_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpc_endpoint": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network_interface": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"availability_zone": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"network_interface_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"private_ip_address": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"subnet_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"vpc_endpoint_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vpc_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"enhanced_vpc_routing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"workgroup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workgroup_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("workgroup_name").(string)
+	input := &redshiftserverless.CreateWorkgroupInput{
+		EnhancedVpcRouting: aws.Bool(d.Get("enhanced_vpc_routing").(bool)),
+		NamespaceName:      aws.String(d.Get("namespace_name").(string)),
+		PubliclyAccessible: aws.Bool(d.Get("publicly_accessible").(bool)),
+		Tags:               Tags(tags.IgnoreAWS()),
+		WorkgroupName:      aws.String(name),
+	}
+
+	if v, ok := d.GetOk("base_capacity"); ok {
+		input.BaseCapacity = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("config_parameter"); ok && v.(*schema.Set).Len() > 0 {
+		input.ConfigParameters = expandConfigParameters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	_, err := conn.CreateWorkgroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Redshift Serverless Workgroup (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitWorkgroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Redshift Serverless Workgroup (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceWorkgroupRead(ctx, d, meta)
+}
+
+func resourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindWorkgroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Serverless Workgroup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Redshift Serverless Workgroup (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.WorkgroupArn)
+	d.Set("arn", arn)
+	d.Set("base_capacity", output.BaseCapacity)
+	if err := d.Set("config_parameter", flattenConfigParameters(output.ConfigParameters)); err != nil {
+		return diag.Errorf("error setting config_parameter: %s", err)
+	}
+	if err := d.Set("endpoint", flattenEndpoint(output.Endpoint)); err != nil {
+		return diag.Errorf("error setting endpoint: %s", err)
+	}
+	d.Set("enhanced_vpc_routing", output.EnhancedVpcRouting)
+	d.Set("namespace_name", output.NamespaceName)
+	d.Set("publicly_accessible", output.PubliclyAccessible)
+	d.Set("security_group_ids", aws.StringValueSlice(output.SecurityGroupIds))
+	d.Set("subnet_ids", aws.StringValueSlice(output.SubnetIds))
+	d.Set("workgroup_id", output.WorkgroupId)
+	d.Set("workgroup_name", output.WorkgroupName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Redshift Serverless Workgroup (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	// Only one workgroup setting can be updated at a time.
+	if d.HasChange("base_capacity") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			BaseCapacity:  aws.Int64(int64(d.Get("base_capacity").(int))),
+			WorkgroupName: aws.String(d.Id()),
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("config_parameter") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			ConfigParameters: expandConfigParameters(d.Get("config_parameter").(*schema.Set).List()),
+			WorkgroupName:    aws.String(d.Id()),
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("enhanced_vpc_routing") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			EnhancedVpcRouting: aws.Bool(d.Get("enhanced_vpc_routing").(bool)),
+			WorkgroupName:      aws.String(d.Id()),
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("publicly_accessible") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			PubliclyAccessible: aws.Bool(d.Get("publicly_accessible").(bool)),
+			WorkgroupName:      aws.String(d.Id()),
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("security_group_ids") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			SecurityGroupIds: flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+			WorkgroupName:    aws.String(d.Id()),
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("subnet_ids") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			SubnetIds:     flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+			WorkgroupName: aws.String(d.Id()),
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Redshift Serverless Workgroup (%s) tags: %s", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceWorkgroupRead(ctx, d, meta)
+}
+
+func resourceWorkgroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	log.Printf("[DEBUG] Deleting Redshift Serverless Workgroup: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteWorkgroupWithContext(ctx, &redshiftserverless.DeleteWorkgroupInput{
+			WorkgroupName: aws.String(d.Id()),
+		})
+	}, redshiftserverless.ErrCodeConflictException)
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Redshift Serverless Workgroup (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitWorkgroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Redshift Serverless Workgroup (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateWorkgroup(ctx context.Context, conn *redshiftserverless.RedshiftServerless, input *redshiftserverless.UpdateWorkgroupInput, timeout time.Duration) error {
+	name := aws.StringValue(input.WorkgroupName)
+
+	log.Printf("[DEBUG] Updating Redshift Serverless Workgroup: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout, func() (interface{}, error) {
+		return conn.UpdateWorkgroupWithContext(ctx, input)
+	}, redshiftserverless.ErrCodeConflictException)
+
+	if err != nil {
+		return fmt.Errorf("error updating Redshift Serverless Workgroup (%s): %w", name, err)
+	}
+
+	if _, err := waitWorkgroupAvailable(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for Redshift Serverless Workgroup (%s) update: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandConfigParameters(tfList []interface{}) []*redshiftserverless.ConfigParameter {
+	var apiObjects []*redshiftserverless.ConfigParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &redshiftserverless.ConfigParameter{}
+
+		if v, ok := tfMap["parameter_key"].(string); ok && v != "" {
+			apiObject.ParameterKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["parameter_value"].(string); ok && v != "" {
+			apiObject.ParameterValue = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenConfigParameters(apiObjects []*redshiftserverless.ConfigParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter_key":   aws.StringValue(apiObject.ParameterKey),
+			"parameter_value": aws.StringValue(apiObject.ParameterValue),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEndpoint(apiObject *redshiftserverless.Endpoint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"address": aws.StringValue(apiObject.Address),
+		"port":    aws.Int64Value(apiObject.Port),
+	}
+
+	var vpcEndpoints []interface{}
+	for _, vpcEndpoint := range apiObject.VpcEndpoints {
+		if vpcEndpoint == nil {
+			continue
+		}
+
+		var networkInterfaces []interface{}
+		for _, networkInterface := range vpcEndpoint.NetworkInterfaces {
+			if networkInterface == nil {
+				continue
+			}
+
+			networkInterfaces = append(networkInterfaces, map[string]interface{}{
+				"availability_zone":    aws.StringValue(networkInterface.AvailabilityZone),
+				"network_interface_id": aws.StringValue(networkInterface.NetworkInterfaceId),
+				"private_ip_address":   aws.StringValue(networkInterface.PrivateIpAddress),
+				"subnet_id":            aws.StringValue(networkInterface.SubnetId),
+			})
+		}
+
+		vpcEndpoints = append(vpcEndpoints, map[string]interface{}{
+			"network_interface": networkInterfaces,
+			"vpc_endpoint_id":   aws.StringValue(vpcEndpoint.VpcEndpointId),
+			"vpc_id":            aws.StringValue(vpcEndpoint.VpcId),
+		})
+	}
+	tfMap["vpc_endpoint"] = vpcEndpoints
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,332 @@
+package redshiftserverless_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftServerlessWorkgroup_basic(t *testing.T) {
+	resourceName := "aws_redshiftserverless_workgroup.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkgroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "redshift-serverless", regexp.MustCompile("workgroup/.+$")),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_name", "aws_redshiftserverless_namespace.test", "namespace_name"),
+					resource.TestCheckResourceAttr(resourceName, "workgroup_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "workgroup_id"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint.0.address"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.port", "5439"),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_vpc_routing", "false"),
+					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessWorkgroup_baseCapacity(t *testing.T) {
+	resourceName := "aws_redshiftserverless_workgroup.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkgroupConfigBaseCapacity(rName, 64),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "base_capacity", "64"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkgroupConfigBaseCapacity(rName, 128),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "base_capacity", "128"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessWorkgroup_configParameters(t *testing.T) {
+	resourceName := "aws_redshiftserverless_workgroup.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkgroupConfigConfigParameter(rName, "14400"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "config_parameter.*", map[string]string{
+						"parameter_key":   "max_query_execution_time",
+						"parameter_value": "14400",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkgroupConfigConfigParameter(rName, "28800"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "config_parameter.*", map[string]string{
+						"parameter_key":   "max_query_execution_time",
+						"parameter_value": "28800",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessWorkgroup_tags(t *testing.T) {
+	resourceName := "aws_redshiftserverless_workgroup.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkgroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkgroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccWorkgroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessWorkgroup_disappears(t *testing.T) {
+	resourceName := "aws_redshiftserverless_workgroup.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkgroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkgroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkgroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshiftserverless.ResourceWorkgroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkgroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshiftserverless_workgroup" {
+			continue
+		}
+
+		_, err := tfredshiftserverless.FindWorkgroupByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Serverless Workgroup %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckWorkgroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Serverless Workgroup ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+		_, err := tfredshiftserverless.FindWorkgroupByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccWorkgroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+`, rName)
+}
+
+func testAccWorkgroupConfigBaseCapacity(rName string, baseCapacity int) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+  base_capacity  = %[2]d
+}
+`, rName, baseCapacity)
+}
+
+func testAccWorkgroupConfigConfigParameter(rName, maxQueryExecutionTime string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+
+  config_parameter {
+    parameter_key   = "datestyle"
+    parameter_value = "ISO, MDY"
+  }
+
+  config_parameter {
+    parameter_key   = "enable_user_activity_logging"
+    parameter_value = "true"
+  }
+
+  config_parameter {
+    parameter_key   = "query_group"
+    parameter_value = "default"
+  }
+
+  config_parameter {
+    parameter_key   = "search_path"
+    parameter_value = "$user, public"
+  }
+
+  config_parameter {
+    parameter_key   = "max_query_execution_time"
+    parameter_value = %[2]q
+  }
+}
+`, rName, maxQueryExecutionTime)
+}
+
+func testAccWorkgroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccWorkgroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,103 @@
+package resourcegroups
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindGroupByName(conn *resourcegroups.ResourceGroups, name string) (*resourcegroups.Group, error) {
+	input := &resourcegroups.GetGroupInput{
+		GroupName: aws.String(name),
+	}
+
+	output, err := conn.GetGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Group == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Group, nil
+}
+
+func FindGroupConfigurationByGroupName(conn *resourcegroups.ResourceGroups, groupName string) (*resourcegroups.GroupConfiguration, error) {
+	input := &resourcegroups.GetGroupConfigurationInput{
+		Group: aws.String(groupName),
+	}
+
+	output, err := conn.GetGroupConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.GroupConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.GroupConfiguration, nil
+}
+
+func FindResourceByTwoPartKey(conn *resourcegroups.ResourceGroups, groupARN, resourceARN string) (*resourcegroups.ListGroupResourcesItem, error) {
+	input := &resourcegroups.ListGroupResourcesInput{
+		Group: aws.String(groupARN),
+	}
+	var output *resourcegroups.ListGroupResourcesItem
+
+	err := conn.ListGroupResourcesPages(input, func(page *resourcegroups.ListGroupResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Resources {
+			if v == nil || v.Identifier == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Identifier.ResourceArn) == resourceARN {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -3,6 +3,7 @@ package resourcegroups
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -25,6 +27,11 @@ func ResourceGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -32,6 +39,37 @@ func ResourceGroup() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameters": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				AtLeastOneOf: []string{"configuration", "resource_query"},
+			},
+
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -39,8 +77,7 @@ func ResourceGroup() *schema.Resource {
 
 			"resource_query": {
 				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
+				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -59,6 +96,7 @@ func ResourceGroup() *schema.Resource {
 						},
 					},
 				},
+				AtLeastOneOf: []string{"configuration", "resource_query"},
 			},
 
 			"arn": {
@@ -88,10 +126,19 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := resourcegroups.CreateGroupInput{
-		Description:   aws.String(d.Get("description").(string)),
-		Name:          aws.String(d.Get("name").(string)),
-		ResourceQuery: extractResourceGroupResourceQuery(d.Get("resource_query").([]interface{})),
-		Tags:          Tags(tags.IgnoreAWS()),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+		Tags:        Tags(tags.IgnoreAWS()),
+	}
+
+	waitForConfigurationAttached := false
+	if v, ok := d.GetOk("configuration"); ok && v.(*schema.Set).Len() > 0 {
+		input.Configuration = expandGroupConfigurationItems(v.(*schema.Set).List())
+		waitForConfigurationAttached = true
+	}
+
+	if v, ok := d.GetOk("resource_query"); ok && len(v.([]interface{})) > 0 {
+		input.ResourceQuery = extractResourceGroupResourceQuery(v.([]interface{}))
 	}
 
 	res, err := conn.CreateGroup(&input)
@@ -101,6 +148,12 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(res.Group.Name))
 
+	if waitForConfigurationAttached {
+		if _, err := WaitGroupConfigurationUpdated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for resource group (%s) configuration update: %w", d.Id(), err)
+		}
+	}
+
 	return resourceGroupRead(d, meta)
 }
 
@@ -128,19 +181,44 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", g.Group.Description)
 	d.Set("arn", arn)
 
+	groupCfg, err := FindGroupConfigurationByGroupName(conn, d.Id())
+
+	hasQuery := true
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeBadRequestException) {
+			// Attempting to get configuration on a query-based group throws BadRequestException.
+			groupCfg = &resourcegroups.GroupConfiguration{}
+		} else {
+			return fmt.Errorf("error reading configuration for resource group (%s): %w", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("configuration", flattenGroupConfigurationItems(groupCfg.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
+	// A group with a configuration, such as an EC2 capacity reservation pool, may not have a resource query.
+	if len(groupCfg.Configuration) > 0 {
+		hasQuery = false
+	}
+
 	q, err := conn.GetGroupQuery(&resourcegroups.GetGroupQueryInput{
 		GroupName: aws.String(d.Id()),
 	})
 
-	if err != nil {
+	if err != nil && !(tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeBadRequestException) && !hasQuery) {
 		return fmt.Errorf("error reading resource query for resource group (%s): %s", d.Id(), err)
 	}
 
-	resultQuery := map[string]interface{}{}
-	resultQuery["query"] = aws.StringValue(q.GroupQuery.ResourceQuery.Query)
-	resultQuery["type"] = aws.StringValue(q.GroupQuery.ResourceQuery.Type)
-	if err := d.Set("resource_query", []map[string]interface{}{resultQuery}); err != nil {
-		return fmt.Errorf("error setting resource_query: %s", err)
+	if q != nil && q.GroupQuery != nil && q.GroupQuery.ResourceQuery != nil {
+		resultQuery := map[string]interface{}{}
+		resultQuery["query"] = aws.StringValue(q.GroupQuery.ResourceQuery.Query)
+		resultQuery["type"] = aws.StringValue(q.GroupQuery.ResourceQuery.Type)
+		if err := d.Set("resource_query", []map[string]interface{}{resultQuery}); err != nil {
+			return fmt.Errorf("error setting resource_query: %s", err)
+		}
+	} else {
+		d.Set("resource_query", nil)
 	}
 
 	tags, err := ListTags(conn, arn)
@@ -176,7 +254,7 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("resource_query") {
+	if d.HasChange("resource_query") && len(d.Get("resource_query").([]interface{})) > 0 {
 		input := resourcegroups.UpdateGroupQueryInput{
 			GroupName:     aws.String(d.Id()),
 			ResourceQuery: extractResourceGroupResourceQuery(d.Get("resource_query").([]interface{})),
@@ -188,6 +266,22 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("configuration") {
+		input := resourcegroups.PutGroupConfigurationInput{
+			Configuration: expandGroupConfigurationItems(d.Get("configuration").(*schema.Set).List()),
+			Group:         aws.String(d.Id()),
+		}
+
+		_, err := conn.PutGroupConfiguration(&input)
+		if err != nil {
+			return fmt.Errorf("error updating configuration for resource group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitGroupConfigurationUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for resource group (%s) configuration update: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
@@ -212,3 +306,86 @@ func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandGroupConfigurationParameters(tfList []interface{}) []*resourcegroups.GroupConfigurationParameter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*resourcegroups.GroupConfigurationParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &resourcegroups.GroupConfigurationParameter{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: flex.ExpandStringList(tfMap["values"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGroupConfigurationItems(tfList []interface{}) []*resourcegroups.GroupConfigurationItem {
+	var apiObjects []*resourcegroups.GroupConfigurationItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &resourcegroups.GroupConfigurationItem{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["parameters"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandGroupConfigurationParameters(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenGroupConfigurationParameters(apiObjects []*resourcegroups.GroupConfigurationParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"values": aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
+
+func flattenGroupConfigurationItems(apiObjects []*resourcegroups.GroupConfigurationItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameters": flattenGroupConfigurationParameters(apiObject.Parameters),
+			"type":       aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
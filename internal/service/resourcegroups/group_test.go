@@ -114,6 +114,39 @@ func TestAccResourceGroupsGroup_Resource_tags(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupsGroup_Resource_configuration(t *testing.T) {
+	var v resourcegroups.Group
+	resourceName := "aws_resourcegroups_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupConfig_capacityReservationPool(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.*.type", "AWS::EC2::CapacityReservationPool"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"type":         "AWS::ResourceGroups::Generic",
+						"parameters.#": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckResourceGroupExists(n string, v *resourcegroups.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -241,3 +274,33 @@ JSON
 }
 `, rName, desc, query, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccResourceGroupConfig_capacityReservationPool(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::EC2::CapacityReservationPool"
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name = "allowed-resource-types"
+      values = [
+        "AWS::EC2::CapacityReservation",
+      ]
+    }
+
+    parameters {
+      name = "deletion-protection"
+      values = [
+        "UNLESS_EMPTY",
+      ]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,177 @@
+package resourcegroups
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceCreate,
+		Read:   resourceResourceRead,
+		Delete: resourceResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsConn
+
+	groupARN := d.Get("group_arn").(string)
+	resourceARN := d.Get("resource_arn").(string)
+	id := ResourceCreateResourceID(groupARN, resourceARN)
+	input := &resourcegroups.GroupResourcesInput{
+		Group:        aws.String(groupARN),
+		ResourceArns: aws.StringSlice([]string{resourceARN}),
+	}
+
+	log.Printf("[DEBUG] Creating Resource Groups Resource: %s", input)
+	output, err := conn.GroupResources(input)
+
+	if err == nil && output != nil {
+		err = failedResourcesError(output.Failed)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Resource Groups Resource (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitResourceCreated(conn, groupARN, resourceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Resource Groups Resource (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceResourceRead(d, meta)
+}
+
+func resourceResourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsConn
+
+	groupARN, resourceARN, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindResourceByTwoPartKey(conn, groupARN, resourceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Groups Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Resource Groups Resource (%s): %w", d.Id(), err)
+	}
+
+	d.Set("group_arn", groupARN)
+	d.Set("resource_arn", output.Identifier.ResourceArn)
+	d.Set("resource_type", output.Identifier.ResourceType)
+
+	return nil
+}
+
+func resourceResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsConn
+
+	groupARN, resourceARN, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Resource Groups Resource: %s", d.Id())
+	output, err := conn.UngroupResources(&resourcegroups.UngroupResourcesInput{
+		Group:        aws.String(groupARN),
+		ResourceArns: aws.StringSlice([]string{resourceARN}),
+	})
+
+	if err == nil && output != nil {
+		err = failedResourcesError(output.Failed)
+	}
+
+	if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Resource Groups Resource (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitResourceDeleted(conn, groupARN, resourceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Resource Groups Resource (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const resourceResourceIDSeparator = ","
+
+func ResourceCreateResourceID(groupARN, resourceARN string) string {
+	parts := []string{groupARN, resourceARN}
+	id := strings.Join(parts, resourceResourceIDSeparator)
+
+	return id
+}
+
+func ResourceParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GroupARN%[2]sResourceARN", id, resourceResourceIDSeparator)
+}
+
+func failedResourcesError(apiObjects []*resourcegroups.FailedResource) error {
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		return awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage), nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package resourcegroups
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func StatusGroupConfiguration(conn *resourcegroups.ResourceGroups, groupName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGroupConfigurationByGroupName(conn, groupName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// StatusResource returns the status of a resource's group membership.
+// A resource that has been fully added to the group has no status.
+func StatusResource(conn *resourcegroups.ResourceGroups, groupARN, resourceARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindResourceByTwoPartKey(conn, groupARN, resourceARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.Name), nil
+	}
+}
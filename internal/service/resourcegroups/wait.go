@@ -0,0 +1,68 @@
+package resourcegroups
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func WaitGroupConfigurationUpdated(conn *resourcegroups.ResourceGroups, groupName string, timeout time.Duration) (*resourcegroups.GroupConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcegroups.GroupConfigurationStatusUpdating},
+		Target:  []string{resourcegroups.GroupConfigurationStatusUpdateComplete},
+		Refresh: StatusGroupConfiguration(conn, groupName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*resourcegroups.GroupConfiguration); ok {
+		if status := aws.StringValue(output.Status); status == resourcegroups.GroupConfigurationStatusUpdateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitResourceCreated(conn *resourcegroups.ResourceGroups, groupARN, resourceARN string, timeout time.Duration) (*resourcegroups.ListGroupResourcesItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{resourcegroups.ResourceStatusValuePending},
+		Target:                    []string{""},
+		Refresh:                   StatusResource(conn, groupARN, resourceARN),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*resourcegroups.ListGroupResourcesItem); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitResourceDeleted(conn *resourcegroups.ResourceGroups, groupARN, resourceARN string, timeout time.Duration) (*resourcegroups.ListGroupResourcesItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", resourcegroups.ResourceStatusValuePending},
+		Target:  []string{},
+		Refresh: StatusResource(conn, groupARN, resourceARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*resourcegroups.ListGroupResourcesItem); ok {
+		return output, err
+	}
+
+	return nil, err
+}
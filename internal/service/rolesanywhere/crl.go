@@ -0,0 +1,209 @@
+package rolesanywhere
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCRL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCRLCreate,
+		ReadContext:   resourceCRLRead,
+		UpdateContext: resourceCRLUpdate,
+		DeleteContext: resourceCRLDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crl_data": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trust_anchor_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCRLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &rolesanywhere.ImportCrlInput{
+		CrlData:        []byte(d.Get("crl_data").(string)),
+		Name:           aws.String(name),
+		TrustAnchorArn: aws.String(d.Get("trust_anchor_arn").(string)),
+	}
+
+	if v, ok := d.GetOkExists("enabled"); ok {
+		input.Enabled = aws.Bool(v.(bool))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Importing RolesAnywhere CRL: %s", name)
+	output, err := conn.ImportCrlWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error importing RolesAnywhere CRL (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Crl.CrlId))
+
+	return resourceCRLRead(ctx, d, meta)
+}
+
+func resourceCRLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	crl, err := FindCRLByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RolesAnywhere CRL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(crl.CrlArn)
+	d.Set("arn", arn)
+	d.Set("crl_data", string(crl.CrlData))
+	d.Set("enabled", crl.Enabled)
+	d.Set("name", crl.Name)
+	d.Set("trust_anchor_arn", crl.TrustAnchorArn)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCRLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+
+	if d.HasChanges("crl_data", "name") {
+		input := &rolesanywhere.UpdateCrlInput{
+			CrlId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("crl_data") {
+			input.CrlData = []byte(d.Get("crl_data").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating RolesAnywhere CRL: %s", d.Id())
+		_, err := conn.UpdateCrlWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating RolesAnywhere CRL (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.EnableCrlWithContext(ctx, &rolesanywhere.EnableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.DisableCrlWithContext(ctx, &rolesanywhere.DisableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return diag.Errorf("error setting RolesAnywhere CRL (%s) enabled: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating RolesAnywhere CRL (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCRLRead(ctx, d, meta)
+}
+
+func resourceCRLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+
+	log.Printf("[INFO] Deleting RolesAnywhere CRL: %s", d.Id())
+	_, err := conn.DeleteCrlWithContext(ctx, &rolesanywhere.DeleteCrlInput{
+		CrlId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rolesanywhere.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package rolesanywhere_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrolesanywhere "github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRolesAnywhereCRL_basic(t *testing.T) {
+	var v rolesanywhere.CrlDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	trustAnchorResourceName := "aws_rolesanywhere_trust_anchor.test"
+	caCertificate, crl := testAccCRLCertificates(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCRLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig(rName, caCertificate, crl, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rolesanywhere", regexp.MustCompile(`crl/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_anchor_arn", trustAnchorResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCRLConfig(rName, caCertificate, crl, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_disappears(t *testing.T) {
+	var v rolesanywhere.CrlDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caCertificate, crl := testAccCRLCertificates(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCRLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig(rName, caCertificate, crl, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrolesanywhere.ResourceCRL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCRLDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rolesanywhere_crl" {
+			continue
+		}
+
+		_, err := tfrolesanywhere.FindCRLByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RolesAnywhere CRL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCRLExists(n string, v *rolesanywhere.CrlDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RolesAnywhere CRL ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
+
+		output, err := tfrolesanywhere.FindCRLByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCRLCertificates returns a self-signed CA certificate PEM and an empty
+// CRL PEM signed by that CA.
+func testAccCRLCertificates(t *testing.T) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ca := &x509.Certificate{
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		NotBefore:             time.Now(),
+		SerialNumber:          big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName:   "ACME Root CA",
+			Organization: []string{"ACME Examples, Inc"},
+		},
+		SubjectKeyId: []byte{1, 2, 3, 4},
+	}
+
+	caBytes, err := x509.CreateCertificate(rand.Reader, ca, ca, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ca, err = x509.ParseCertificate(caBytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crlBytes, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		NextUpdate: time.Now().Add(24 * time.Hour),
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+	}, ca, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caBytes})
+	crlPEM := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlBytes})
+
+	return string(caPEM), string(crlPEM)
+}
+
+func testAccCRLConfig(rName, caCertificate, crl string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+}
+
+resource "aws_rolesanywhere_crl" "test" {
+  name             = %[1]q
+  crl_data         = "%[3]s"
+  enabled          = %[4]t
+  trust_anchor_arn = aws_rolesanywhere_trust_anchor.test.arn
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), acctest.TLSPEMEscapeNewlines(crl), enabled)
+}
@@ -0,0 +1,86 @@
+package rolesanywhere
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCRLByID(ctx context.Context, conn *rolesanywhere.RolesAnywhere, id string) (*rolesanywhere.CrlDetail, error) {
+	input := &rolesanywhere.GetCrlInput{
+		CrlId: aws.String(id),
+	}
+
+	output, err := conn.GetCrlWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rolesanywhere.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Crl == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Crl, nil
+}
+
+func FindProfileByID(ctx context.Context, conn *rolesanywhere.RolesAnywhere, id string) (*rolesanywhere.ProfileDetail, error) {
+	input := &rolesanywhere.GetProfileInput{
+		ProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rolesanywhere.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Profile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Profile, nil
+}
+
+func FindTrustAnchorByID(ctx context.Context, conn *rolesanywhere.RolesAnywhere, id string) (*rolesanywhere.TrustAnchorDetail, error) {
+	input := &rolesanywhere.GetTrustAnchorInput{
+		TrustAnchorId: aws.String(id),
+	}
+
+	output, err := conn.GetTrustAnchorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rolesanywhere.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustAnchor == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustAnchor, nil
+}
@@ -0,0 +1,259 @@
+package rolesanywhere
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProfileCreate,
+		ReadContext:   resourceProfileRead,
+		UpdateContext: resourceProfileUpdate,
+		DeleteContext: resourceProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(900, 43200),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"managed_policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"require_instance_properties": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"role_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"session_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &rolesanywhere.CreateProfileInput{
+		Name:     aws.String(name),
+		RoleArns: flex.ExpandStringSet(d.Get("role_arns").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("duration_seconds"); ok {
+		input.DurationSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOkExists("enabled"); ok {
+		input.Enabled = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("managed_policy_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ManagedPolicyArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("require_instance_properties"); ok {
+		input.RequireInstanceProperties = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("session_policy"); ok {
+		input.SessionPolicy = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating RolesAnywhere Profile: %s", input)
+	output, err := conn.CreateProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating RolesAnywhere Profile (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Profile.ProfileId))
+
+	return resourceProfileRead(ctx, d, meta)
+}
+
+func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	profile, err := FindProfileByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RolesAnywhere Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading RolesAnywhere Profile (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(profile.ProfileArn)
+	d.Set("arn", arn)
+	d.Set("duration_seconds", profile.DurationSeconds)
+	d.Set("enabled", profile.Enabled)
+	d.Set("managed_policy_arns", aws.StringValueSlice(profile.ManagedPolicyArns))
+	d.Set("name", profile.Name)
+	d.Set("require_instance_properties", profile.RequireInstanceProperties)
+	d.Set("role_arns", aws.StringValueSlice(profile.RoleArns))
+	d.Set("session_policy", profile.SessionPolicy)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for RolesAnywhere Profile (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+
+	if d.HasChanges("duration_seconds", "managed_policy_arns", "name", "role_arns", "session_policy") {
+		input := &rolesanywhere.UpdateProfileInput{
+			ManagedPolicyArns: flex.ExpandStringSet(d.Get("managed_policy_arns").(*schema.Set)),
+			Name:              aws.String(d.Get("name").(string)),
+			ProfileId:         aws.String(d.Id()),
+			RoleArns:          flex.ExpandStringSet(d.Get("role_arns").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("duration_seconds"); ok {
+			input.DurationSeconds = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("session_policy"); ok {
+			input.SessionPolicy = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating RolesAnywhere Profile: %s", input)
+		_, err := conn.UpdateProfileWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating RolesAnywhere Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.EnableProfileWithContext(ctx, &rolesanywhere.EnableProfileInput{
+				ProfileId: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.DisableProfileWithContext(ctx, &rolesanywhere.DisableProfileInput{
+				ProfileId: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return diag.Errorf("error setting RolesAnywhere Profile (%s) enabled: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating RolesAnywhere Profile (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProfileRead(ctx, d, meta)
+}
+
+func resourceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+
+	log.Printf("[INFO] Deleting RolesAnywhere Profile: %s", d.Id())
+	_, err := conn.DeleteProfileWithContext(ctx, &rolesanywhere.DeleteProfileInput{
+		ProfileId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rolesanywhere.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting RolesAnywhere Profile (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package rolesanywhere_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrolesanywhere "github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRolesAnywhereProfile_basic(t *testing.T) {
+	var v rolesanywhere.ProfileDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_profile.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rolesanywhere", regexp.MustCompile(`profile/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "duration_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "role_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "role_arns.*", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "session_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereProfile_disappears(t *testing.T) {
+	var v rolesanywhere.ProfileDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrolesanywhere.ResourceProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereProfile_sessionPolicy(t *testing.T) {
+	var v rolesanywhere.ProfileDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileSessionPolicyConfig(rName, 900, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "duration_seconds", "900"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "session_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileSessionPolicyConfig(rName, 1800, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "duration_seconds", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereProfile_tags(t *testing.T) {
+	var v rolesanywhere.ProfileDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccProfileTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProfileDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rolesanywhere_profile" {
+			continue
+		}
+
+		_, err := tfrolesanywhere.FindProfileByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RolesAnywhere Profile %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckProfileExists(n string, v *rolesanywhere.ProfileDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RolesAnywhere Profile ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
+
+		output, err := tfrolesanywhere.FindProfileByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfileBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "sts:AssumeRole",
+        "sts:TagSession",
+        "sts:SetSourceIdentity",
+      ]
+      Effect = "Allow"
+      Principal = {
+        Service = "rolesanywhere.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccProfileConfig(rName string) string {
+	return acctest.ConfigCompose(testAccProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rolesanywhere_profile" "test" {
+  name      = %[1]q
+  role_arns = [aws_iam_role.test.arn]
+}
+`, rName))
+}
+
+func testAccProfileSessionPolicyConfig(rName string, durationSeconds int, enabled bool) string {
+	return acctest.ConfigCompose(testAccProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rolesanywhere_profile" "test" {
+  name             = %[1]q
+  role_arns        = [aws_iam_role.test.arn]
+  duration_seconds = %[2]d
+  enabled          = %[3]t
+
+  session_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListAllMyBuckets"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName, durationSeconds, enabled))
+}
+
+func testAccProfileTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rolesanywhere_profile" "test" {
+  name      = %[1]q
+  role_arns = [aws_iam_role.test.arn]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccProfileTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccProfileBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rolesanywhere_profile" "test" {
+  name      = %[1]q
+  role_arns = [aws_iam_role.test.arn]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
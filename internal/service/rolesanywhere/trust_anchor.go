@@ -0,0 +1,281 @@
+package rolesanywhere
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrustAnchor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrustAnchorCreate,
+		ReadContext:   resourceTrustAnchorRead,
+		UpdateContext: resourceTrustAnchorUpdate,
+		DeleteContext: resourceTrustAnchorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_data": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"acm_pca_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"x509_certificate_data": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(rolesanywhere.TrustAnchorType_Values(), false),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &rolesanywhere.CreateTrustAnchorInput{
+		Name:   aws.String(name),
+		Source: expandSource(d.Get("source").([]interface{})),
+	}
+
+	if v, ok := d.GetOkExists("enabled"); ok {
+		input.Enabled = aws.Bool(v.(bool))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor: %s", input)
+	output, err := conn.CreateTrustAnchorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating RolesAnywhere Trust Anchor (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrustAnchor.TrustAnchorId))
+
+	return resourceTrustAnchorRead(ctx, d, meta)
+}
+
+func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	trustAnchor, err := FindTrustAnchorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RolesAnywhere Trust Anchor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(trustAnchor.TrustAnchorArn)
+	d.Set("arn", arn)
+	d.Set("enabled", trustAnchor.Enabled)
+	d.Set("name", trustAnchor.Name)
+	if err := d.Set("source", flattenSource(trustAnchor.Source)); err != nil {
+		return diag.Errorf("error setting source: %s", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+
+	if d.HasChanges("name", "source") {
+		input := &rolesanywhere.UpdateTrustAnchorInput{
+			Name:          aws.String(d.Get("name").(string)),
+			Source:        expandSource(d.Get("source").([]interface{})),
+			TrustAnchorId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating RolesAnywhere Trust Anchor: %s", input)
+		_, err := conn.UpdateTrustAnchorWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.EnableTrustAnchorWithContext(ctx, &rolesanywhere.EnableTrustAnchorInput{
+				TrustAnchorId: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.DisableTrustAnchorWithContext(ctx, &rolesanywhere.DisableTrustAnchorInput{
+				TrustAnchorId: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return diag.Errorf("error setting RolesAnywhere Trust Anchor (%s) enabled: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating RolesAnywhere Trust Anchor (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTrustAnchorRead(ctx, d, meta)
+}
+
+func resourceTrustAnchorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+
+	log.Printf("[INFO] Deleting RolesAnywhere Trust Anchor: %s", d.Id())
+	_, err := conn.DeleteTrustAnchorWithContext(ctx, &rolesanywhere.DeleteTrustAnchorInput{
+		TrustAnchorId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rolesanywhere.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSource(tfList []interface{}) *rolesanywhere.Source {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rolesanywhere.Source{}
+
+	if v, ok := tfMap["source_data"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourceData = expandSourceData(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_type"].(string); ok && v != "" {
+		apiObject.SourceType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceData(tfMap map[string]interface{}) *rolesanywhere.SourceData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rolesanywhere.SourceData{}
+
+	if v, ok := tfMap["acm_pca_arn"].(string); ok && v != "" {
+		apiObject.AcmPcaArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["x509_certificate_data"].(string); ok && v != "" {
+		apiObject.X509CertificateData = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSource(apiObject *rolesanywhere.Source) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"source_type": aws.StringValue(apiObject.SourceType),
+	}
+
+	if v := apiObject.SourceData; v != nil {
+		tfMap["source_data"] = []interface{}{map[string]interface{}{
+			"acm_pca_arn":           aws.StringValue(v.AcmPcaArn),
+			"x509_certificate_data": aws.StringValue(v.X509CertificateData),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,297 @@
+package rolesanywhere_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rolesanywhere"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrolesanywhere "github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRolesAnywhereTrustAnchor_basic(t *testing.T) {
+	var v rolesanywhere.TrustAnchorDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrustAnchorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorConfig(rName, caCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rolesanywhere", regexp.MustCompile(`trust-anchor/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.source_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.source_type", rolesanywhere.TrustAnchorTypeCertificateBundle),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereTrustAnchor_disappears(t *testing.T) {
+	var v rolesanywhere.TrustAnchorDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrustAnchorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorConfig(rName, caCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrolesanywhere.ResourceTrustAnchor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereTrustAnchor_enabled(t *testing.T) {
+	var v rolesanywhere.TrustAnchorDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrustAnchorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorEnabledConfig(rName, caCertificate, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustAnchorEnabledConfig(rName, caCertificate, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereTrustAnchor_tags(t *testing.T) {
+	var v rolesanywhere.TrustAnchorDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rolesanywhere.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrustAnchorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorTags1Config(rName, caCertificate, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustAnchorTags2Config(rName, caCertificate, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTrustAnchorTags1Config(rName, caCertificate, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrustAnchorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rolesanywhere_trust_anchor" {
+			continue
+		}
+
+		_, err := tfrolesanywhere.FindTrustAnchorByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RolesAnywhere Trust Anchor %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTrustAnchorExists(n string, v *rolesanywhere.TrustAnchorDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RolesAnywhere Trust Anchor ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
+
+		output, err := tfrolesanywhere.FindTrustAnchorByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
+
+	_, err := conn.ListTrustAnchorsWithContext(context.Background(), &rolesanywhere.ListTrustAnchorsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccTrustAnchorConfig(rName, caCertificate string) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate))
+}
+
+func testAccTrustAnchorEnabledConfig(rName, caCertificate string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name    = %[1]q
+  enabled = %[3]t
+
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), enabled)
+}
+
+func testAccTrustAnchorTags1Config(rName, caCertificate, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), tagKey1, tagValue1)
+}
+
+func testAccTrustAnchorTags2Config(rName, caCertificate, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,633 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLifecycleConfigurationCreate,
+		Read:   resourceBucketLifecycleConfigurationRead,
+		Update: resourceBucketLifecycleConfigurationUpdate,
+		Delete: resourceBucketLifecycleConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"object_size_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"object_size_less_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tags": tftags.TagsSchema(),
+											},
+										},
+									},
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"newer_noncurrent_versions": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	rules, err := expandS3BucketLifecycleRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	_, err = retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	outputRaw, err := retryWhenNewConfigurationNotFound(d.IsNewResource(), func() (interface{}, error) {
+		return conn.GetBucketLifecycleConfiguration(input)
+	}, ErrCodeNoSuchLifecycleConfiguration)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	output, ok := outputRaw.(*s3.GetBucketLifecycleConfigurationOutput)
+
+	if !ok || output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenS3BucketLifecycleRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	rules, err := expandS3BucketLifecycleRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	_, err = conn.PutBucketLifecycleConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketLifecycle(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3BucketLifecycleRules(tfList []interface{}) ([]*s3.LifecycleRule, error) {
+	var apiObjects []*s3.LifecycleRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.LifecycleRule{
+			ID:     aws.String(tfMap["id"].(string)),
+			Status: aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(v[0].(map[string]interface{})["days_after_initiation"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			expiration, err := expandS3BucketLifecycleExpiration(v[0].(map[string]interface{}))
+
+			if err != nil {
+				return nil, err
+			}
+
+			apiObject.Expiration = expiration
+		}
+
+		// A rule without a filter applies to all objects in the bucket.
+		apiObject.Filter = &s3.LifecycleRuleFilter{Prefix: aws.String("")}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Filter = expandS3BucketLifecycleRuleFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			expiration := &s3.NoncurrentVersionExpiration{}
+
+			if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v > 0 {
+				expiration.NewerNoncurrentVersions = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["noncurrent_days"].(int); ok && v > 0 {
+				expiration.NoncurrentDays = aws.Int64(int64(v))
+			}
+
+			apiObject.NoncurrentVersionExpiration = expiration
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				transition := &s3.NoncurrentVersionTransition{
+					NoncurrentDays: aws.Int64(int64(tfMap["noncurrent_days"].(int))),
+					StorageClass:   aws.String(tfMap["storage_class"].(string)),
+				}
+
+				if v, ok := tfMap["newer_noncurrent_versions"].(int); ok && v > 0 {
+					transition.NewerNoncurrentVersions = aws.Int64(int64(v))
+				}
+
+				apiObject.NoncurrentVersionTransitions = append(apiObject.NoncurrentVersionTransitions, transition)
+			}
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				transition := &s3.Transition{
+					StorageClass: aws.String(tfMap["storage_class"].(string)),
+				}
+
+				if v, ok := tfMap["date"].(string); ok && v != "" {
+					t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+
+					if err != nil {
+						return nil, fmt.Errorf("error parsing transition date (%s): %w", v, err)
+					}
+
+					transition.Date = aws.Time(t)
+				} else {
+					transition.Days = aws.Int64(int64(tfMap["days"].(int)))
+				}
+
+				apiObject.Transitions = append(apiObject.Transitions, transition)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandS3BucketLifecycleExpiration(tfMap map[string]interface{}) (*s3.LifecycleExpiration, error) {
+	apiObject := &s3.LifecycleExpiration{}
+
+	if v, ok := tfMap["date"].(string); ok && v != "" {
+		t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing expiration date (%s): %w", v, err)
+		}
+
+		apiObject.Date = aws.Time(t)
+	} else if v, ok := tfMap["days"].(int); ok && v > 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	} else if v, ok := tfMap["expired_object_delete_marker"].(bool); ok {
+		apiObject.ExpiredObjectDeleteMarker = aws.Bool(v)
+	}
+
+	return apiObject, nil
+}
+
+func expandS3BucketLifecycleRuleFilter(tfMap map[string]interface{}) *s3.LifecycleRuleFilter {
+	apiObject := &s3.LifecycleRuleFilter{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		and := &s3.LifecycleRuleAndOperator{}
+
+		if v, ok := tfMap["object_size_greater_than"].(int); ok && v > 0 {
+			and.ObjectSizeGreaterThan = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["object_size_less_than"].(int); ok && v > 0 {
+			and.ObjectSizeLessThan = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			and.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			and.Tags = Tags(tftags.New(v).IgnoreAWS())
+		}
+
+		apiObject.And = and
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["object_size_greater_than"].(int); ok && v > 0 {
+		apiObject.ObjectSizeGreaterThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than"].(int); ok && v > 0 {
+		apiObject.ObjectSizeLessThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tag = &s3.Tag{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+	}
+
+	if apiObject.ObjectSizeGreaterThan == nil && apiObject.ObjectSizeLessThan == nil && apiObject.Tag == nil {
+		apiObject.Prefix = aws.String(tfMap["prefix"].(string))
+	}
+
+	return apiObject
+}
+
+func flattenS3BucketLifecycleRules(apiObjects []*s3.LifecycleRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":     aws.StringValue(apiObject.ID),
+			"status": aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+			tfMap["abort_incomplete_multipart_upload"] = []interface{}{
+				map[string]interface{}{
+					"days_after_initiation": int(aws.Int64Value(v.DaysAfterInitiation)),
+				},
+			}
+		}
+
+		if v := apiObject.Expiration; v != nil {
+			expiration := map[string]interface{}{
+				"days":                         int(aws.Int64Value(v.Days)),
+				"expired_object_delete_marker": aws.BoolValue(v.ExpiredObjectDeleteMarker),
+			}
+
+			if v.Date != nil {
+				expiration["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+			}
+
+			tfMap["expiration"] = []interface{}{expiration}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = flattenS3BucketLifecycleRuleFilter(v)
+		}
+
+		if v := apiObject.NoncurrentVersionExpiration; v != nil {
+			tfMap["noncurrent_version_expiration"] = []interface{}{
+				map[string]interface{}{
+					"newer_noncurrent_versions": int(aws.Int64Value(v.NewerNoncurrentVersions)),
+					"noncurrent_days":           int(aws.Int64Value(v.NoncurrentDays)),
+				},
+			}
+		}
+
+		if len(apiObject.NoncurrentVersionTransitions) > 0 {
+			var transitions []interface{}
+
+			for _, v := range apiObject.NoncurrentVersionTransitions {
+				transitions = append(transitions, map[string]interface{}{
+					"newer_noncurrent_versions": int(aws.Int64Value(v.NewerNoncurrentVersions)),
+					"noncurrent_days":           int(aws.Int64Value(v.NoncurrentDays)),
+					"storage_class":             aws.StringValue(v.StorageClass),
+				})
+			}
+
+			tfMap["noncurrent_version_transition"] = transitions
+		}
+
+		if len(apiObject.Transitions) > 0 {
+			var transitions []interface{}
+
+			for _, v := range apiObject.Transitions {
+				transition := map[string]interface{}{
+					"days":          int(aws.Int64Value(v.Days)),
+					"storage_class": aws.StringValue(v.StorageClass),
+				}
+
+				if v.Date != nil {
+					transition["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+				}
+
+				transitions = append(transitions, transition)
+			}
+
+			tfMap["transition"] = transitions
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3BucketLifecycleRuleFilter(apiObject *s3.LifecycleRuleFilter) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{
+			map[string]interface{}{
+				"object_size_greater_than": int(aws.Int64Value(v.ObjectSizeGreaterThan)),
+				"object_size_less_than":    int(aws.Int64Value(v.ObjectSizeLessThan)),
+				"prefix":                   aws.StringValue(v.Prefix),
+				"tags":                     KeyValueTags(v.Tags).IgnoreAWS().Map(),
+			},
+		}
+	}
+
+	if v := apiObject.ObjectSizeGreaterThan; v != nil {
+		tfMap["object_size_greater_than"] = int(aws.Int64Value(v))
+	}
+
+	if v := apiObject.ObjectSizeLessThan; v != nil {
+		tfMap["object_size_less_than"] = int(aws.Int64Value(v))
+	}
+
+	if v := aws.StringValue(apiObject.Prefix); v != "" {
+		tfMap["prefix"] = v
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(v.Key),
+				"value": aws.StringValue(v.Value),
+			},
+		}
+	}
+
+	// An empty filter is equivalent to no filter at all.
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
@@ -174,6 +174,16 @@ func ResourceBucketObject() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -420,7 +430,18 @@ func resourceBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*sc
 
 func resourceBucketObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
-	uploader := s3manager.NewUploaderWithClient(conn)
+	// Objects larger than the part size are streamed from the source in parts
+	// with a multipart upload. Each part request is retried on its own, so a
+	// transient failure does not restart the upload from the beginning.
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
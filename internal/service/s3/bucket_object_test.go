@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -111,6 +112,43 @@ func TestAccS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObject_sourceMultipart(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// Larger than the minimum part size so that the object is uploaded in two parts.
+	data := strings.Repeat("0123456789abcdef", 6*1024*1024/16)
+	source := testAccBucketObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectConfig_sourceMultipart(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectExists(resourceName, &obj),
+					testAccCheckBucketObjectBody(&obj, data),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-2$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "source", "source_hash", "force_destroy", "upload_concurrency", "upload_part_size"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketObject_content(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -1597,6 +1635,23 @@ resource "aws_s3_bucket_object" "object" {
 `, rName, source)
 }
 
+func testAccBucketObjectConfig_sourceMultipart(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  source_hash        = filemd5(%[2]q)
+  upload_concurrency = 2
+  upload_part_size   = 5242880
+}
+`, rName, source)
+}
+
 func testAccBucketObjectConfig_withContentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
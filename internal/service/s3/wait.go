@@ -15,3 +15,13 @@ const (
 func retryWhenBucketNotFound(f func() (interface{}, error)) (interface{}, error) {
 	return tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, f, s3.ErrCodeNoSuchBucket)
 }
+
+// retryWhenNewConfigurationNotFound retries f while the bucket or the given
+// configuration of a newly created resource is not yet visible.
+func retryWhenNewConfigurationNotFound(isNewResource bool, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	if !isNewResource {
+		return f()
+	}
+
+	return tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, f, append([]string{s3.ErrCodeNoSuchBucket}, codes...)...)
+}
@@ -0,0 +1,126 @@
+package vpclattice
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAuthPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthPolicyPut,
+		ReadContext:   resourceAuthPolicyRead,
+		UpdateContext: resourceAuthPolicyPut,
+		DeleteContext: resourceAuthPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAuthPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policy, err)
+	}
+
+	resourceID := d.Get("resource_identifier").(string)
+	input := &vpclattice.PutAuthPolicyInput{
+		Policy:             aws.String(policy),
+		ResourceIdentifier: aws.String(resourceID),
+	}
+
+	log.Printf("[DEBUG] Putting VPC Lattice Auth Policy: %s", input)
+	_, err = conn.PutAuthPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting VPC Lattice Auth Policy (%s): %s", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceAuthPolicyRead(ctx, d, meta)
+}
+
+func resourceAuthPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	output, err := FindAuthPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Auth Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading VPC Lattice Auth Policy (%s): %s", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+	if err != nil {
+		return diag.Errorf("setting policy %s: %s", aws.StringValue(output.Policy), err)
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("resource_identifier", d.Id())
+	d.Set("state", output.State)
+
+	return nil
+}
+
+func resourceAuthPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[INFO] Deleting VPC Lattice Auth Policy: %s", d.Id())
+	_, err := conn.DeleteAuthPolicyWithContext(ctx, &vpclattice.DeleteAuthPolicyInput{
+		ResourceIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting VPC Lattice Auth Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCLatticeAuthPolicy_basic(t *testing.T) {
+	var v vpclattice.GetAuthPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_auth_policy.test"
+	serviceResourceName := "aws_vpclattice_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAuthPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthPolicyConfig(rName, "vpc-lattice-svcs:Invoke"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthPolicyExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`vpc-lattice-svcs:Invoke`)),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_identifier", serviceResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAuthPolicyConfig(rName, "vpc-lattice-svcs:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthPolicyExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`vpc-lattice-svcs:\*`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeAuthPolicy_disappears(t *testing.T) {
+	var v vpclattice.GetAuthPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_auth_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAuthPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthPolicyConfig(rName, "vpc-lattice-svcs:Invoke"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthPolicyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfvpclattice.ResourceAuthPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuthPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpclattice_auth_policy" {
+			continue
+		}
+
+		_, err := tfvpclattice.FindAuthPolicyByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("VPC Lattice Auth Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAuthPolicyExists(n string, v *vpclattice.GetAuthPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Auth Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+		output, err := tfvpclattice.FindAuthPolicyByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAuthPolicyConfig(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpclattice_service" "test" {
+  name      = %[1]q
+  auth_type = "AWS_IAM"
+}
+
+resource "aws_vpclattice_auth_policy" "test" {
+  resource_identifier = aws_vpclattice_service.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = %[2]q
+      Effect    = "Allow"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+      Resource = "*"
+    }]
+  })
+}
+`, rName, action)
+}
@@ -0,0 +1,187 @@
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAuthPolicyByID(ctx context.Context, conn *vpclattice.VPCLattice, id string) (*vpclattice.GetAuthPolicyOutput, error) {
+	input := &vpclattice.GetAuthPolicyInput{
+		ResourceIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetAuthPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindListenerByTwoPartKey(ctx context.Context, conn *vpclattice.VPCLattice, serviceID, listenerID string) (*vpclattice.GetListenerOutput, error) {
+	input := &vpclattice.GetListenerInput{
+		ListenerIdentifier: aws.String(listenerID),
+		ServiceIdentifier:  aws.String(serviceID),
+	}
+
+	output, err := conn.GetListenerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindServiceByID(ctx context.Context, conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceOutput, error) {
+	input := &vpclattice.GetServiceInput{
+		ServiceIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetServiceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindServiceNetworkByID(ctx context.Context, conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkOutput, error) {
+	input := &vpclattice.GetServiceNetworkInput{
+		ServiceNetworkIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetServiceNetworkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindServiceNetworkServiceAssociationByID(ctx context.Context, conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	input := &vpclattice.GetServiceNetworkServiceAssociationInput{
+		ServiceNetworkServiceAssociationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetServiceNetworkServiceAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindServiceNetworkVPCAssociationByID(ctx context.Context, conn *vpclattice.VPCLattice, id string) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	input := &vpclattice.GetServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetServiceNetworkVpcAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTargetGroupByID(ctx context.Context, conn *vpclattice.VPCLattice, id string) (*vpclattice.GetTargetGroupOutput, error) {
+	input := &vpclattice.GetTargetGroupInput{
+		TargetGroupIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetTargetGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,25 @@
+package vpclattice
+
+import (
+	"fmt"
+	"strings"
+)
+
+const listenerResourceIDSeparator = "/"
+
+func ListenerCreateResourceID(serviceID, listenerID string) string {
+	parts := []string{serviceID, listenerID}
+	id := strings.Join(parts, listenerResourceIDSeparator)
+
+	return id
+}
+
+func ListenerParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, listenerResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected service-id%[2]slistener-id", id, listenerResourceIDSeparator)
+}
@@ -0,0 +1,367 @@
+package vpclattice
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceListener() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceListenerCreate,
+		ReadContext:   resourceListenerRead,
+		UpdateContext: resourceListenerUpdate,
+		DeleteContext: resourceListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fixed_response": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"default_action.0.fixed_response", "default_action.0.forward"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status_code": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(100, 599),
+									},
+								},
+							},
+						},
+						"forward": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"default_action.0.fixed_response", "default_action.0.forward"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_groups": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"target_group_identifier": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"weight": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      100,
+													ValidateFunc: validation.IntBetween(0, 999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(vpclattice.ListenerProtocol_Values(), false),
+			},
+			"service_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &vpclattice.CreateListenerInput{
+		DefaultAction:     expandRuleAction(d.Get("default_action").([]interface{})),
+		Name:              aws.String(name),
+		Protocol:          aws.String(d.Get("protocol").(string)),
+		ServiceIdentifier: aws.String(d.Get("service_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Listener: %s", input)
+	output, err := conn.CreateListenerWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating VPC Lattice Listener (%s): %s", name, err)
+	}
+
+	d.SetId(ListenerCreateResourceID(aws.StringValue(output.ServiceId), aws.StringValue(output.Id)))
+
+	return resourceListenerRead(ctx, d, meta)
+}
+
+func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	serviceID, listenerID, err := ListenerParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindListenerByTwoPartKey(ctx, conn, serviceID, listenerID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading VPC Lattice Listener (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("default_action", flattenRuleAction(output.DefaultAction)); err != nil {
+		return diag.Errorf("error setting default_action: %s", err)
+	}
+	d.Set("listener_id", output.Id)
+	d.Set("name", output.Name)
+	d.Set("port", output.Port)
+	d.Set("protocol", output.Protocol)
+	d.Set("service_arn", output.ServiceArn)
+	// The service may be referenced by either ID or ARN.
+	if _, ok := d.GetOk("service_identifier"); !ok {
+		d.Set("service_identifier", output.ServiceId)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for VPC Lattice Listener (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	serviceID, listenerID, err := ListenerParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("default_action") {
+		input := &vpclattice.UpdateListenerInput{
+			DefaultAction:      expandRuleAction(d.Get("default_action").([]interface{})),
+			ListenerIdentifier: aws.String(listenerID),
+			ServiceIdentifier:  aws.String(serviceID),
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Listener: %s", input)
+		_, err := conn.UpdateListenerWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating VPC Lattice Listener (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating VPC Lattice Listener (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceListenerRead(ctx, d, meta)
+}
+
+func resourceListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	serviceID, listenerID, err := ListenerParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting VPC Lattice Listener: %s", d.Id())
+	_, err = conn.DeleteListenerWithContext(ctx, &vpclattice.DeleteListenerInput{
+		ListenerIdentifier: aws.String(listenerID),
+		ServiceIdentifier:  aws.String(serviceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting VPC Lattice Listener (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRuleAction(tfList []interface{}) *vpclattice.RuleAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &vpclattice.RuleAction{}
+
+	if v, ok := tfMap["fixed_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FixedResponse = &vpclattice.FixedResponseAction{
+			StatusCode: aws.Int64(int64(v[0].(map[string]interface{})["status_code"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["forward"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Forward = &vpclattice.ForwardAction{
+			TargetGroups: expandWeightedTargetGroups(v[0].(map[string]interface{})["target_groups"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandWeightedTargetGroups(tfList []interface{}) []*vpclattice.WeightedTargetGroup {
+	var apiObjects []*vpclattice.WeightedTargetGroup
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &vpclattice.WeightedTargetGroup{}
+
+		if v, ok := tfMap["target_group_identifier"].(string); ok && v != "" {
+			apiObject.TargetGroupIdentifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["weight"].(int); ok {
+			apiObject.Weight = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRuleAction(apiObject *vpclattice.RuleAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FixedResponse; v != nil {
+		tfMap["fixed_response"] = []interface{}{map[string]interface{}{
+			"status_code": aws.Int64Value(v.StatusCode),
+		}}
+	}
+
+	if v := apiObject.Forward; v != nil {
+		tfMap["forward"] = []interface{}{map[string]interface{}{
+			"target_groups": flattenWeightedTargetGroups(v.TargetGroups),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenWeightedTargetGroups(apiObjects []*vpclattice.WeightedTargetGroup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"target_group_identifier": aws.StringValue(apiObject.TargetGroupIdentifier),
+			"weight":                  aws.Int64Value(apiObject.Weight),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,356 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCLatticeListener_basic(t *testing.T) {
+	var v vpclattice.GetListenerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener.test"
+	serviceResourceName := "aws_vpclattice_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerFixedResponseConfig(rName, 404),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "vpc-lattice", regexp.MustCompile(`service/.+/listener/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.fixed_response.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.fixed_response.0.status_code", "404"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "listener_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_arn", serviceResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_identifier", serviceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccListenerFixedResponseConfig(rName, 503),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.fixed_response.0.status_code", "503"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeListener_disappears(t *testing.T) {
+	var v vpclattice.GetListenerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerFixedResponseConfig(rName, 404),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfvpclattice.ResourceListener(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeListener_forward(t *testing.T) {
+	var v vpclattice.GetListenerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener.test"
+	targetGroup1ResourceName := "aws_vpclattice_target_group.test.0"
+	targetGroup2ResourceName := "aws_vpclattice_target_group.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerForwardConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.fixed_response.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.target_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_action.0.forward.0.target_groups.0.target_group_identifier", targetGroup1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.target_groups.0.weight", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccListenerForwardWeightedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.target_groups.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_action.0.forward.0.target_groups.0.target_group_identifier", targetGroup1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.target_groups.0.weight", "80"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_action.0.forward.0.target_groups.1.target_group_identifier", targetGroup2ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.target_groups.1.weight", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeListener_tags(t *testing.T) {
+	var v vpclattice.GetListenerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccListenerTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccListenerTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckListenerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpclattice_listener" {
+			continue
+		}
+
+		serviceID, listenerID, err := tfvpclattice.ListenerParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfvpclattice.FindListenerByTwoPartKey(context.Background(), conn, serviceID, listenerID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("VPC Lattice Listener %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckListenerExists(n string, v *vpclattice.GetListenerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Listener ID is set")
+		}
+
+		serviceID, listenerID, err := tfvpclattice.ListenerParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+		output, err := tfvpclattice.FindListenerByTwoPartKey(context.Background(), conn, serviceID, listenerID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccListenerConfigBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVpcWithSubnets(1), fmt.Sprintf(`
+resource "aws_vpclattice_service" "test" {
+  name = %[1]q
+}
+
+resource "aws_vpclattice_target_group" "test" {
+  count = 2
+
+  name = "${%[1]q}-${count.index}"
+  type = "INSTANCE"
+
+  config {
+    port           = 80
+    protocol       = "HTTP"
+    vpc_identifier = aws_vpc.test.id
+  }
+}
+`, rName))
+}
+
+func testAccListenerFixedResponseConfig(rName string, statusCode int) string {
+	return acctest.ConfigCompose(testAccListenerConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener" "test" {
+  name               = %[1]q
+  protocol           = "HTTP"
+  service_identifier = aws_vpclattice_service.test.id
+
+  default_action {
+    fixed_response {
+      status_code = %[2]d
+    }
+  }
+}
+`, rName, statusCode))
+}
+
+func testAccListenerForwardConfig(rName string) string {
+	return acctest.ConfigCompose(testAccListenerConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener" "test" {
+  name               = %[1]q
+  protocol           = "HTTP"
+  service_identifier = aws_vpclattice_service.test.id
+
+  default_action {
+    forward {
+      target_groups {
+        target_group_identifier = aws_vpclattice_target_group.test[0].id
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccListenerForwardWeightedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccListenerConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener" "test" {
+  name               = %[1]q
+  protocol           = "HTTP"
+  service_identifier = aws_vpclattice_service.test.id
+
+  default_action {
+    forward {
+      target_groups {
+        target_group_identifier = aws_vpclattice_target_group.test[0].id
+        weight                  = 80
+      }
+
+      target_groups {
+        target_group_identifier = aws_vpclattice_target_group.test[1].id
+        weight                  = 20
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccListenerTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccListenerConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener" "test" {
+  name               = %[1]q
+  protocol           = "HTTP"
+  service_identifier = aws_vpclattice_service.test.id
+
+  default_action {
+    fixed_response {
+      status_code = 404
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccListenerTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccListenerConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener" "test" {
+  name               = %[1]q
+  protocol           = "HTTP"
+  service_identifier = aws_vpclattice_service.test.id
+
+  default_action {
+    fixed_response {
+      status_code = 404
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,251 @@
+package vpclattice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceCreate,
+		ReadContext:   resourceServiceRead,
+		UpdateContext: resourceServiceUpdate,
+		DeleteContext: resourceServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(vpclattice.AuthType_Values(), false),
+			},
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_domain_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"dns_entry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 40),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &vpclattice.CreateServiceInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("auth_type"); ok {
+		input.AuthType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("certificate_arn"); ok {
+		input.CertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_domain_name"); ok {
+		input.CustomDomainName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Service: %s", input)
+	output, err := conn.CreateServiceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating VPC Lattice Service (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := WaitServiceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Service (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindServiceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading VPC Lattice Service (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("auth_type", output.AuthType)
+	d.Set("certificate_arn", output.CertificateArn)
+	d.Set("custom_domain_name", output.CustomDomainName)
+	if err := d.Set("dns_entry", flattenDNSEntry(output.DnsEntry)); err != nil {
+		return diag.Errorf("error setting dns_entry: %s", err)
+	}
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for VPC Lattice Service (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChanges("auth_type", "certificate_arn") {
+		input := &vpclattice.UpdateServiceInput{
+			ServiceIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("auth_type") {
+			input.AuthType = aws.String(d.Get("auth_type").(string))
+		}
+
+		if d.HasChange("certificate_arn") {
+			input.CertificateArn = aws.String(d.Get("certificate_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Service: %s", input)
+		_, err := conn.UpdateServiceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating VPC Lattice Service (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating VPC Lattice Service (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[INFO] Deleting VPC Lattice Service: %s", d.Id())
+	_, err := conn.DeleteServiceWithContext(ctx, &vpclattice.DeleteServiceInput{
+		ServiceIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting VPC Lattice Service (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitServiceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Service (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenDNSEntry(apiObject *vpclattice.DnsEntry) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"domain_name":    aws.StringValue(apiObject.DomainName),
+		"hosted_zone_id": aws.StringValue(apiObject.HostedZoneId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,204 @@
+package vpclattice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceNetworkServiceAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceNetworkServiceAssociationCreate,
+		ReadContext:   resourceServiceNetworkServiceAssociationRead,
+		UpdateContext: resourceServiceNetworkServiceAssociationUpdate,
+		DeleteContext: resourceServiceNetworkServiceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_entry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_network_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceNetworkServiceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &vpclattice.CreateServiceNetworkServiceAssociationInput{
+		ServiceIdentifier:        aws.String(d.Get("service_identifier").(string)),
+		ServiceNetworkIdentifier: aws.String(d.Get("service_network_identifier").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Service Network Service Association: %s", input)
+	output, err := conn.CreateServiceNetworkServiceAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating VPC Lattice Service Network Service Association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := WaitServiceNetworkServiceAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Service Network Service Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceServiceNetworkServiceAssociationRead(ctx, d, meta)
+}
+
+func resourceServiceNetworkServiceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindServiceNetworkServiceAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Service Network Service Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading VPC Lattice Service Network Service Association (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("created_by", output.CreatedBy)
+	d.Set("custom_domain_name", output.CustomDomainName)
+	if err := d.Set("dns_entry", flattenDNSEntry(output.DnsEntry)); err != nil {
+		return diag.Errorf("error setting dns_entry: %s", err)
+	}
+	// The service and service network may be referenced by either ID or ARN.
+	if _, ok := d.GetOk("service_identifier"); !ok {
+		d.Set("service_identifier", output.ServiceId)
+	}
+	if _, ok := d.GetOk("service_network_identifier"); !ok {
+		d.Set("service_network_identifier", output.ServiceNetworkId)
+	}
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for VPC Lattice Service Network Service Association (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceServiceNetworkServiceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating VPC Lattice Service Network Service Association (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceServiceNetworkServiceAssociationRead(ctx, d, meta)
+}
+
+func resourceServiceNetworkServiceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[INFO] Deleting VPC Lattice Service Network Service Association: %s", d.Id())
+	_, err := conn.DeleteServiceNetworkServiceAssociationWithContext(ctx, &vpclattice.DeleteServiceNetworkServiceAssociationInput{
+		ServiceNetworkServiceAssociationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting VPC Lattice Service Network Service Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitServiceNetworkServiceAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Service Network Service Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCLatticeServiceNetworkServiceAssociation_basic(t *testing.T) {
+	var v vpclattice.GetServiceNetworkServiceAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network_service_association.test"
+	serviceResourceName := "aws_vpclattice_service.test"
+	serviceNetworkResourceName := "aws_vpclattice_service_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkServiceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkServiceAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkServiceAssociationExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "vpc-lattice", regexp.MustCompile(`servicenetworkserviceassociation/.+`)),
+					acctest.CheckResourceAttrAccountID(resourceName, "created_by"),
+					resource.TestCheckResourceAttr(resourceName, "dns_entry.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_identifier", serviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_network_identifier", serviceNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworkServiceAssociation_disappears(t *testing.T) {
+	var v vpclattice.GetServiceNetworkServiceAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network_service_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkServiceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkServiceAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkServiceAssociationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfvpclattice.ResourceServiceNetworkServiceAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworkServiceAssociation_tags(t *testing.T) {
+	var v vpclattice.GetServiceNetworkServiceAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network_service_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkServiceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkServiceAssociationTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkServiceAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceNetworkServiceAssociationTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkServiceAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccServiceNetworkServiceAssociationTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkServiceAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceNetworkServiceAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpclattice_service_network_service_association" {
+			continue
+		}
+
+		_, err := tfvpclattice.FindServiceNetworkServiceAssociationByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("VPC Lattice Service Network Service Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckServiceNetworkServiceAssociationExists(n string, v *vpclattice.GetServiceNetworkServiceAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Service Network Service Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+		output, err := tfvpclattice.FindServiceNetworkServiceAssociationByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServiceNetworkServiceAssociationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_service" "test" {
+  name = %[1]q
+}
+
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccServiceNetworkServiceAssociationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkServiceAssociationConfigBase(rName), `
+resource "aws_vpclattice_service_network_service_association" "test" {
+  service_identifier         = aws_vpclattice_service.test.id
+  service_network_identifier = aws_vpclattice_service_network.test.id
+}
+`)
+}
+
+func testAccServiceNetworkServiceAssociationTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkServiceAssociationConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_service_network_service_association" "test" {
+  service_identifier         = aws_vpclattice_service.test.id
+  service_network_identifier = aws_vpclattice_service_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccServiceNetworkServiceAssociationTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkServiceAssociationConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_service_network_service_association" "test" {
+  service_identifier         = aws_vpclattice_service.test.id
+  service_network_identifier = aws_vpclattice_service_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,254 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCLatticeServiceNetwork_basic(t *testing.T) {
+	var v vpclattice.GetServiceNetworkOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "vpc-lattice", regexp.MustCompile(`servicenetwork/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetwork_disappears(t *testing.T) {
+	var v vpclattice.GetServiceNetworkOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfvpclattice.ResourceServiceNetwork(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetwork_authType(t *testing.T) {
+	var v vpclattice.GetServiceNetworkOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkAuthTypeConfig(rName, "AWS_IAM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "AWS_IAM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceNetworkAuthTypeConfig(rName, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetwork_tags(t *testing.T) {
+	var v vpclattice.GetServiceNetworkOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceNetworkTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccServiceNetworkTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceNetworkDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpclattice_service_network" {
+			continue
+		}
+
+		_, err := tfvpclattice.FindServiceNetworkByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("VPC Lattice Service Network %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckServiceNetworkExists(n string, v *vpclattice.GetServiceNetworkOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Service Network ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+		output, err := tfvpclattice.FindServiceNetworkByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	_, err := conn.ListServiceNetworksWithContext(context.Background(), &vpclattice.ListServiceNetworksInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccServiceNetworkConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccServiceNetworkAuthTypeConfig(rName, authType string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_service_network" "test" {
+  name      = %[1]q
+  auth_type = %[2]q
+}
+`, rName, authType)
+}
+
+func testAccServiceNetworkTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccServiceNetworkTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,210 @@
+package vpclattice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceNetworkVPCAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceNetworkVPCAssociationCreate,
+		ReadContext:   resourceServiceNetworkVPCAssociationRead,
+		UpdateContext: resourceServiceNetworkVPCAssociationUpdate,
+		DeleteContext: resourceServiceNetworkVPCAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_network_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceNetworkVPCAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &vpclattice.CreateServiceNetworkVpcAssociationInput{
+		ServiceNetworkIdentifier: aws.String(d.Get("service_network_identifier").(string)),
+		VpcIdentifier:            aws.String(d.Get("vpc_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Service Network VPC Association: %s", input)
+	output, err := conn.CreateServiceNetworkVpcAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating VPC Lattice Service Network VPC Association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := WaitServiceNetworkVPCAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Service Network VPC Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceServiceNetworkVPCAssociationRead(ctx, d, meta)
+}
+
+func resourceServiceNetworkVPCAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindServiceNetworkVPCAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Service Network VPC Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading VPC Lattice Service Network VPC Association (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("created_by", output.CreatedBy)
+	d.Set("security_group_ids", aws.StringValueSlice(output.SecurityGroupIds))
+	// The service network may be referenced by either ID or ARN.
+	if _, ok := d.GetOk("service_network_identifier"); !ok {
+		d.Set("service_network_identifier", output.ServiceNetworkId)
+	}
+	d.Set("status", output.Status)
+	d.Set("vpc_identifier", output.VpcId)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for VPC Lattice Service Network VPC Association (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceServiceNetworkVPCAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChange("security_group_ids") {
+		input := &vpclattice.UpdateServiceNetworkVpcAssociationInput{
+			SecurityGroupIds:                       flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+			ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Service Network VPC Association: %s", input)
+		_, err := conn.UpdateServiceNetworkVpcAssociationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating VPC Lattice Service Network VPC Association (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitServiceNetworkVPCAssociationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for VPC Lattice Service Network VPC Association (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating VPC Lattice Service Network VPC Association (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceServiceNetworkVPCAssociationRead(ctx, d, meta)
+}
+
+func resourceServiceNetworkVPCAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[INFO] Deleting VPC Lattice Service Network VPC Association: %s", d.Id())
+	_, err := conn.DeleteServiceNetworkVpcAssociationWithContext(ctx, &vpclattice.DeleteServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting VPC Lattice Service Network VPC Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitServiceNetworkVPCAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Service Network VPC Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCLatticeServiceNetworkVPCAssociation_basic(t *testing.T) {
+	var v vpclattice.GetServiceNetworkVpcAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network_vpc_association.test"
+	serviceNetworkResourceName := "aws_vpclattice_service_network.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkVPCAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkVPCAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkVPCAssociationExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "vpc-lattice", regexp.MustCompile(`servicenetworkvpcassociation/.+`)),
+					acctest.CheckResourceAttrAccountID(resourceName, "created_by"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_network_identifier", serviceNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_identifier", vpcResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworkVPCAssociation_disappears(t *testing.T) {
+	var v vpclattice.GetServiceNetworkVpcAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network_vpc_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkVPCAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkVPCAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkVPCAssociationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfvpclattice.ResourceServiceNetworkVPCAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworkVPCAssociation_securityGroupIDs(t *testing.T) {
+	var v vpclattice.GetServiceNetworkVpcAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network_vpc_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkVPCAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkVPCAssociationSecurityGroupIDsConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkVPCAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test.0", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceNetworkVPCAssociationSecurityGroupIDsConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkVPCAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworkVPCAssociation_tags(t *testing.T) {
+	var v vpclattice.GetServiceNetworkVpcAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service_network_vpc_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceNetworkVPCAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkVPCAssociationTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkVPCAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceNetworkVPCAssociationTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkVPCAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccServiceNetworkVPCAssociationTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkVPCAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceNetworkVPCAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpclattice_service_network_vpc_association" {
+			continue
+		}
+
+		_, err := tfvpclattice.FindServiceNetworkVPCAssociationByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("VPC Lattice Service Network VPC Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckServiceNetworkVPCAssociationExists(n string, v *vpclattice.GetServiceNetworkVpcAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Service Network VPC Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+		output, err := tfvpclattice.FindServiceNetworkVPCAssociationByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServiceNetworkVPCAssociationConfigBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVpcWithSubnets(1), fmt.Sprintf(`
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccServiceNetworkVPCAssociationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkVPCAssociationConfigBase(rName), `
+resource "aws_vpclattice_service_network_vpc_association" "test" {
+  service_network_identifier = aws_vpclattice_service_network.test.id
+  vpc_identifier             = aws_vpc.test.id
+}
+`)
+}
+
+func testAccServiceNetworkVPCAssociationSecurityGroupIDsConfig(rName string, count int) string {
+	return acctest.ConfigCompose(testAccServiceNetworkVPCAssociationConfigBase(rName), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  count = 2
+
+  name   = "${%[1]q}-${count.index}"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpclattice_service_network_vpc_association" "test" {
+  service_network_identifier = aws_vpclattice_service_network.test.id
+  vpc_identifier             = aws_vpc.test.id
+  security_group_ids         = slice(aws_security_group.test[*].id, 0, %[2]d)
+}
+`, rName, count))
+}
+
+func testAccServiceNetworkVPCAssociationTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkVPCAssociationConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_service_network_vpc_association" "test" {
+  service_network_identifier = aws_vpclattice_service_network.test.id
+  vpc_identifier             = aws_vpc.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccServiceNetworkVPCAssociationTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkVPCAssociationConfigBase(rName), fmt.Sprintf(`
+resource "aws_vpclattice_service_network_vpc_association" "test" {
+  service_network_identifier = aws_vpclattice_service_network.test.id
+  vpc_identifier             = aws_vpc.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,74 @@
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func StatusService(ctx context.Context, conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func StatusServiceNetworkServiceAssociation(ctx context.Context, conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceNetworkServiceAssociationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func StatusServiceNetworkVPCAssociation(ctx context.Context, conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceNetworkVPCAssociationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func StatusTargetGroup(ctx context.Context, conn *vpclattice.VPCLattice, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTargetGroupByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
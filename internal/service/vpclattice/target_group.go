@@ -0,0 +1,467 @@
+package vpclattice
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTargetGroupCreate,
+		ReadContext:   resourceTargetGroupRead,
+		UpdateContext: resourceTargetGroupUpdate,
+		DeleteContext: resourceTargetGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"health_check": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"health_check_interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"health_check_timeout_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 120),
+									},
+									"healthy_threshold_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+									"matcher": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"value": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupProtocol_Values(), false),
+									},
+									"protocol_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(vpclattice.HealthCheckProtocolVersion_Values(), false),
+									},
+									"unhealthy_threshold_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+								},
+							},
+						},
+						"ip_address_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.IpAddressType_Values(), false),
+						},
+						"lambda_event_structure_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.LambdaEventStructureVersion_Values(), false),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupProtocol_Values(), false),
+						},
+						"protocol_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupProtocolVersion_Values(), false),
+						},
+						"vpc_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(vpclattice.TargetGroupType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &vpclattice.CreateTargetGroupInput{
+		Name: aws.String(name),
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Config = expandTargetGroupConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating VPC Lattice Target Group: %s", input)
+	output, err := conn.CreateTargetGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating VPC Lattice Target Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := WaitTargetGroupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Target Group (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTargetGroupRead(ctx, d, meta)
+}
+
+func resourceTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindTargetGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Lattice Target Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading VPC Lattice Target Group (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	if output.Config != nil {
+		if err := d.Set("config", []interface{}{flattenTargetGroupConfig(output.Config)}); err != nil {
+			return diag.Errorf("error setting config: %s", err)
+		}
+	} else {
+		d.Set("config", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("type", output.Type)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for VPC Lattice Target Group (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	if d.HasChange("config.0.health_check") {
+		input := &vpclattice.UpdateTargetGroupInput{
+			HealthCheck:           &vpclattice.HealthCheckConfig{},
+			TargetGroupIdentifier: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("config.0.health_check"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.HealthCheck = expandHealthCheckConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating VPC Lattice Target Group: %s", input)
+		_, err := conn.UpdateTargetGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating VPC Lattice Target Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating VPC Lattice Target Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTargetGroupRead(ctx, d, meta)
+}
+
+func resourceTargetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeConn
+
+	log.Printf("[INFO] Deleting VPC Lattice Target Group: %s", d.Id())
+	_, err := conn.DeleteTargetGroupWithContext(ctx, &vpclattice.DeleteTargetGroupInput{
+		TargetGroupIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, vpclattice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting VPC Lattice Target Group (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitTargetGroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for VPC Lattice Target Group (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTargetGroupConfig(tfMap map[string]interface{}) *vpclattice.TargetGroupConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.TargetGroupConfig{}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandHealthCheckConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ip_address_type"].(string); ok && v != "" {
+		apiObject.IpAddressType = aws.String(v)
+	}
+
+	if v, ok := tfMap["lambda_event_structure_version"].(string); ok && v != "" {
+		apiObject.LambdaEventStructureVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol_version"].(string); ok && v != "" {
+		apiObject.ProtocolVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_identifier"].(string); ok && v != "" {
+		apiObject.VpcIdentifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHealthCheckConfig(tfMap map[string]interface{}) *vpclattice.HealthCheckConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &vpclattice.HealthCheckConfig{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["health_check_interval_seconds"].(int); ok && v != 0 {
+		apiObject.HealthCheckIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["health_check_timeout_seconds"].(int); ok && v != 0 {
+		apiObject.HealthCheckTimeoutSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["healthy_threshold_count"].(int); ok && v != 0 {
+		apiObject.HealthyThresholdCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["matcher"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["value"].(string); ok && v != "" {
+			apiObject.Matcher = &vpclattice.Matcher{
+				HttpCode: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["path"].(string); ok && v != "" {
+		apiObject.Path = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol_version"].(string); ok && v != "" {
+		apiObject.ProtocolVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["unhealthy_threshold_count"].(int); ok && v != 0 {
+		apiObject.UnhealthyThresholdCount = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTargetGroupConfig(apiObject *vpclattice.TargetGroupConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ip_address_type":                aws.StringValue(apiObject.IpAddressType),
+		"lambda_event_structure_version": aws.StringValue(apiObject.LambdaEventStructureVersion),
+		"port":                           aws.Int64Value(apiObject.Port),
+		"protocol":                       aws.StringValue(apiObject.Protocol),
+		"protocol_version":               aws.StringValue(apiObject.ProtocolVersion),
+		"vpc_identifier":                 aws.StringValue(apiObject.VpcIdentifier),
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{flattenHealthCheckConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHealthCheckConfig(apiObject *vpclattice.HealthCheckConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":                       aws.BoolValue(apiObject.Enabled),
+		"health_check_interval_seconds": aws.Int64Value(apiObject.HealthCheckIntervalSeconds),
+		"health_check_timeout_seconds":  aws.Int64Value(apiObject.HealthCheckTimeoutSeconds),
+		"healthy_threshold_count":       aws.Int64Value(apiObject.HealthyThresholdCount),
+		"path":                          aws.StringValue(apiObject.Path),
+		"port":                          aws.Int64Value(apiObject.Port),
+		"protocol":                      aws.StringValue(apiObject.Protocol),
+		"protocol_version":              aws.StringValue(apiObject.ProtocolVersion),
+		"unhealthy_threshold_count":     aws.Int64Value(apiObject.UnhealthyThresholdCount),
+	}
+
+	if v := apiObject.Matcher; v != nil {
+		tfMap["matcher"] = []interface{}{map[string]interface{}{
+			"value": aws.StringValue(v.HttpCode),
+		}}
+	}
+
+	return tfMap
+}
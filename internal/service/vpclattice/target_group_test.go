@@ -0,0 +1,249 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCLatticeTargetGroup_basic(t *testing.T) {
+	var v vpclattice.GetTargetGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_target_group.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "vpc-lattice", regexp.MustCompile(`targetgroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.ip_address_type", "IPV4"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.protocol_version", "HTTP1"),
+					resource.TestCheckResourceAttrPair(resourceName, "config.0.vpc_identifier", vpcResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "IP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeTargetGroup_disappears(t *testing.T) {
+	var v vpclattice.GetTargetGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfvpclattice.ResourceTargetGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeTargetGroup_healthCheck(t *testing.T) {
+	var v vpclattice.GetTargetGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupHealthCheckConfig(rName, "/health", "200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.health_check_interval_seconds", "20"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.health_check_timeout_seconds", "10"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.healthy_threshold_count", "7"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.matcher.0.value", "200"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.path", "/health"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.unhealthy_threshold_count", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetGroupHealthCheckConfig(rName, "/ready", "200-299"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.matcher.0.value", "200-299"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.health_check.0.path", "/ready"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeTargetGroup_lambda(t *testing.T) {
+	var v vpclattice.GetTargetGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, vpclattice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupLambdaConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "type", "LAMBDA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTargetGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpclattice_target_group" {
+			continue
+		}
+
+		_, err := tfvpclattice.FindTargetGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("VPC Lattice Target Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTargetGroupExists(n string, v *vpclattice.GetTargetGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Target Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeConn
+
+		output, err := tfvpclattice.FindTargetGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTargetGroupConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVpcWithSubnets(1), fmt.Sprintf(`
+resource "aws_vpclattice_target_group" "test" {
+  name = %[1]q
+  type = "IP"
+
+  config {
+    port           = 80
+    protocol       = "HTTP"
+    vpc_identifier = aws_vpc.test.id
+  }
+}
+`, rName))
+}
+
+func testAccTargetGroupHealthCheckConfig(rName, path, matcher string) string {
+	return acctest.ConfigCompose(acctest.ConfigVpcWithSubnets(1), fmt.Sprintf(`
+resource "aws_vpclattice_target_group" "test" {
+  name = %[1]q
+  type = "INSTANCE"
+
+  config {
+    port           = 80
+    protocol       = "HTTP"
+    vpc_identifier = aws_vpc.test.id
+
+    health_check {
+      enabled                       = true
+      health_check_interval_seconds = 20
+      health_check_timeout_seconds  = 10
+      healthy_threshold_count       = 7
+      unhealthy_threshold_count     = 3
+      path                          = %[2]q
+
+      matcher {
+        value = %[3]q
+      }
+    }
+  }
+}
+`, rName, path, matcher))
+}
+
+func testAccTargetGroupLambdaConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_target_group" "test" {
+  name = %[1]q
+  type = "LAMBDA"
+}
+`, rName)
+}
@@ -0,0 +1,191 @@
+package vpclattice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/vpclattice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func failureError(code, message *string) error {
+	if code == nil && message == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.StringValue(code), aws.StringValue(message))
+}
+
+func WaitServiceCreated(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetServiceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceStatusCreateInProgress},
+		Target:  []string{vpclattice.ServiceStatusActive},
+		Refresh: StatusService(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitServiceDeleted(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetServiceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceStatusActive, vpclattice.ServiceStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: StatusService(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitServiceNetworkServiceAssociationCreated(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkServiceAssociationStatusCreateInProgress},
+		Target:  []string{vpclattice.ServiceNetworkServiceAssociationStatusActive},
+		Refresh: StatusServiceNetworkServiceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkServiceAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitServiceNetworkServiceAssociationDeleted(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkServiceAssociationStatusActive, vpclattice.ServiceNetworkServiceAssociationStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: StatusServiceNetworkServiceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkServiceAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkVpcAssociationStatusCreateInProgress},
+		Target:  []string{vpclattice.ServiceNetworkVpcAssociationStatusActive},
+		Refresh: StatusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitServiceNetworkVPCAssociationUpdated(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkVpcAssociationStatusUpdateInProgress},
+		Target:  []string{vpclattice.ServiceNetworkVpcAssociationStatusActive},
+		Refresh: StatusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.ServiceNetworkVpcAssociationStatusActive, vpclattice.ServiceNetworkVpcAssociationStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: StatusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTargetGroupCreated(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.TargetGroupStatusCreateInProgress},
+		Target:  []string{vpclattice.TargetGroupStatusActive},
+		Refresh: StatusTargetGroup(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTargetGroupDeleted(ctx context.Context, conn *vpclattice.VPCLattice, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpclattice.TargetGroupStatusActive, vpclattice.TargetGroupStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: StatusTargetGroup(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
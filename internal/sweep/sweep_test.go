@@ -87,6 +87,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/s3"